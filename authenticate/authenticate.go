@@ -5,6 +5,7 @@ import (
 	"errors"
 	"net/http"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/iamport/go-iamport/util"
@@ -29,6 +30,8 @@ type Authenticate struct {
 	RestAPIKeyAndSecret []byte
 	Token               string
 	Expired             time.Time
+
+	mu sync.Mutex
 }
 
 // NewAuthenticate 는 api url, http.Client, rest api key, rest api seceret을 파라미터로 받아
@@ -64,11 +67,15 @@ func NewAuthenticate(apiURL string, cli *http.Client, restAPIKey string, restAPI
 
 // GetToken rest api를 호출할 수 있는 token을 return해준다.
 // token이 없거나 만료된 경우 RequestToken을 하여 새로운 토큰을 발급받아 return해준다
+// 여러 goroutine 에서 동시에 호출해도 토큰은 한 번만 발급받는다.
 func (a *Authenticate) GetToken() (string, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
 	now := time.Now()
 
 	if a.Token == "" || a.Expired.IsZero() || a.Expired.Before(now) {
-		err := a.RequestToken()
+		err := a.requestToken()
 		if err != nil {
 			return "", nil
 		}
@@ -80,6 +87,13 @@ func (a *Authenticate) GetToken() (string, error) {
 // RequestToken APIKey와 APISecret을 사용하여 AccessToken을 받아 온다.
 // POST /users/getToken
 func (a *Authenticate) RequestToken() error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	return a.requestToken()
+}
+
+func (a *Authenticate) requestToken() error {
 	urls := []string{a.APIUrl, URLGetToken}
 	urlGetToken := strings.Join(urls, "")
 
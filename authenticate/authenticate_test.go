@@ -1,8 +1,13 @@
 package authenticate
 
 import (
+	"fmt"
 	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -47,3 +52,33 @@ func TestBeforeExpiredGetTokenMustbeSameToken(t *testing.T) {
 
 	assert.Equal(t, token, token2)
 }
+
+func TestGetTokenConcurrent(t *testing.T) {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&requests, 1)
+		fmt.Fprintf(w, `{"code":0,"message":"","response":{"access_token":"token_%d","expired_at":%d}}`, n, time.Now().Add(time.Hour).Unix())
+	}))
+	defer server.Close()
+
+	auth, err := NewAuthenticate(server.URL, server.Client(), RestApiKey, RestApiSecret)
+	assert.NoError(t, err)
+	// 만료된 토큰을 여러 goroutine 이 동시에 갱신한다.
+	auth.Expired = time.Now().Add(-time.Minute)
+
+	var wg sync.WaitGroup
+	tokens := make([]string, 10)
+	for i := range tokens {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			tokens[i], _ = auth.GetToken()
+		}(i)
+	}
+	wg.Wait()
+
+	assert.Equal(t, int32(2), atomic.LoadInt32(&requests))
+	for _, token := range tokens {
+		assert.Equal(t, "token_2", token)
+	}
+}
@@ -0,0 +1,385 @@
+package bulkcancel
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	TypePayment "github.com/iamport/interface/gen_src/go/v1/payment"
+
+	"github.com/iamport/go-iamport/iamport"
+	"github.com/iamport/go-iamport/util"
+)
+
+const (
+	ErrDuplicateItem   = "iamport: duplicate bulk cancel item"
+	ErrMerchantUIDDiff = "iamport: merchant_uid of payment is different"
+	ErrNotPaid         = "iamport: payment is not paid"
+)
+
+// Item 취소할 결제건
+type Item struct {
+	ImpUID      string `json:"imp_uid,omitempty"`      // 비어있으면 MerchantUID 로 주문 처리 기준 결제건을 찾는다.
+	MerchantUID string `json:"merchant_uid,omitempty"` // ImpUID 와 함께 지정하면 결제건의 merchant_uid 와 같은지 확인한다.
+	Amount      int32  `json:"amount,omitempty"`       // 취소할 금액, 0이면 취소 가능 잔액 전부
+	TaxFree     int32  `json:"tax_free,omitempty"`     // 취소할 금액 중 면세공급가액
+	Reason      string `json:"reason,omitempty"`
+
+	// Refund 가상계좌 결제건의 환불 계좌
+	Refund iamport.RefundAccount `json:"-"`
+}
+
+// Key 결과 기록의 기준 (imp_uid, 없으면 merchant_uid:{merchant_uid})
+func (i *Item) Key() string {
+	if i.ImpUID != "" {
+		return i.ImpUID
+	}
+
+	return "merchant_uid:" + i.MerchantUID
+}
+
+// Status 결제건별 처리 결과
+type Status string
+
+const (
+	StatusCancelled Status = "cancelled" // 취소 완료
+	StatusValid     Status = "valid"     // DryRun 에서 취소 가능한 것으로 확인됨
+	StatusSkipped   Status = "skipped"   // 이미 전액 취소되어 건너뜀
+	StatusRejected  Status = "rejected"  // 결제 정보와 맞지 않아 취소 요청을 보내지 않음
+	StatusFailed    Status = "failed"    // 취소 요청 실패
+	StatusPending   Status = "pending"   // 취소 요청을 보냈으나 결과를 기록하기 전 (중단된 경우에만 남는다)
+)
+
+// IsFinal 다시 실행해도 처리하지 않는 결과인지 확인한다.
+func (s Status) IsFinal() bool {
+	return s == StatusCancelled || s == StatusSkipped
+}
+
+// Outcome 결제건 한 건의 처리 결과
+type Outcome struct {
+	Key         string `json:"key"`
+	ImpUID      string `json:"imp_uid,omitempty"`
+	MerchantUID string `json:"merchant_uid,omitempty"`
+	Status      Status `json:"status"`
+
+	Amount          int32  `json:"amount"`           // 취소(할) 금액
+	RemainingBefore int32  `json:"remaining_before"` // 취소 전 취소 가능 잔액
+	Remaining       int32  `json:"remaining"`        // 처리 후 취소 가능 잔액
+	Error           string `json:"error,omitempty"`
+}
+
+// Journal 처리 결과 기록. 중단된 작업을 이어서 실행할 때 사용한다.
+type Journal interface {
+	// Load 이전 실행에서 기록된 Key 별 마지막 결과. 기록이 없으면 빈 map
+	Load(ctx context.Context) (map[string]*Outcome, error)
+	// Record 결과를 기록한다. 동시에 호출될 수 있다.
+	Record(ctx context.Context, outcome *Outcome) error
+}
+
+// Options 일괄 취소 조건
+type Options struct {
+	// DryRun 결제 정보로 취소 가능 여부만 확인하고 취소 요청은 보내지 않는다.
+	DryRun bool
+
+	// Concurrency 동시에 처리할 결제건 수. 0 또는 1이면 순서대로 처리한다.
+	Concurrency int
+
+	// Limiter 가 있으면 아임포트 요청(조회, 취소) 전마다 Wait 을 호출한다. 모든 작업이 공유한다.
+	Limiter iamport.Limiter
+
+	// Journal 이 있으면 실행 전에 이전 결과를 읽어 취소 완료, 건너뜀 결제건은 다시 처리하지 않고
+	// 취소 요청 전후로 결과를 기록한다. DryRun 결과는 기록하지 않는다.
+	Journal Journal
+
+	// OnOutcome 결제건 처리가 끝날 때마다 호출된다. 동시에 호출될 수 있다.
+	OnOutcome func(outcome *Outcome)
+}
+
+// Run items 를 취소한다.
+//
+// 결제건마다 결제 정보를 가져와 취소 가능 잔액을 확인한 뒤 잔액을 checksum 으로 부분 취소하므로
+// 같은 결제건이 동시에 다른 곳에서 취소되면 중복 환불되지 않고 실패로 기록된다.
+//
+// Journal 에 취소 요청 직전 pending 을 기록해 두므로, 요청 도중 중단된 뒤 다시 실행하면
+// 결제건의 잔액으로 이전 요청의 처리 여부를 판단하여 중복 취소하지 않는다.
+//
+// 같은 결제건을 imp_uid 와 merchant_uid 로 각각 지정하는 등 서로 다른 Item 이 같은 imp_uid 로 확인되면
+// 먼저 처리된 Item 만 취소하고 나머지는 ErrDuplicateItem 으로 거절한다.
+//
+// ctx 가 끝나거나 Journal 기록이 실패하면 멈추고 그때까지의 결과와 오류를 return 해준다.
+func Run(ctx context.Context, iam *iamport.Iamport, items []*Item, opts *Options) (*Report, error) {
+	if opts == nil {
+		opts = &Options{}
+	}
+
+	keys := map[string]bool{}
+	for _, item := range items {
+		if item.ImpUID == "" && item.MerchantUID == "" {
+			return nil, errors.New(iamport.ErrMustExistImpUIDorMerchantUID)
+		}
+
+		if keys[item.Key()] {
+			return nil, fmt.Errorf("%s: %s", ErrDuplicateItem, item.Key())
+		}
+		keys[item.Key()] = true
+	}
+
+	previous := map[string]*Outcome{}
+	if opts.Journal != nil {
+		var err error
+		if previous, err = opts.Journal.Load(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	r := &runner{iamport: iam, opts: opts, previous: previous, claimed: map[string]string{}}
+	// 이전 실행에서 처리가 끝난 Item 은 다시 조회하지 않으므로 미리 imp_uid 를 선점해 둔다.
+	for _, item := range items {
+		if prev := previous[item.Key()]; prev != nil && prev.Status.IsFinal() && prev.ImpUID != "" {
+			r.claim(prev.ImpUID, item.Key())
+		}
+	}
+
+	outcomes := make([]*Outcome, len(items))
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	concurrency := opts.Concurrency
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	var (
+		wg       sync.WaitGroup
+		errOnce  sync.Once
+		firstErr error
+		indexes  = make(chan int)
+	)
+
+	for w := 0; w < concurrency; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				outcome, err := r.process(ctx, items[i])
+				if err != nil {
+					errOnce.Do(func() {
+						firstErr = err
+						cancel()
+					})
+					continue
+				}
+
+				outcomes[i] = outcome
+				if opts.OnOutcome != nil {
+					opts.OnOutcome(outcome)
+				}
+			}
+		}()
+	}
+
+	for i := range items {
+		if ctx.Err() != nil {
+			break
+		}
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+
+	report := &Report{DryRun: opts.DryRun}
+	for _, outcome := range outcomes {
+		if outcome != nil {
+			report.Outcomes = append(report.Outcomes, outcome)
+		}
+	}
+
+	if firstErr != nil {
+		return report, firstErr
+	}
+
+	return report, ctx.Err()
+}
+
+type runner struct {
+	iamport  *iamport.Iamport
+	opts     *Options
+	previous map[string]*Outcome
+
+	mu      sync.Mutex
+	claimed map[string]string // imp_uid 별로 처리하는 Item 의 Key
+}
+
+// claim imp_uid 를 key 의 Item 이 처리하도록 선점한다. 다른 Item 이 이미 선점했으면 false
+func (r *runner) claim(impUID, key string) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if owner, ok := r.claimed[impUID]; ok && owner != key {
+		return false
+	}
+
+	r.claimed[impUID] = key
+	return true
+}
+
+// process 결제건 한 건을 처리한다. 작업 전체를 멈춰야 하는 경우에만 error 를 return 해준다.
+func (r *runner) process(ctx context.Context, item *Item) (*Outcome, error) {
+	prev := r.previous[item.Key()]
+	if prev != nil && prev.Status.IsFinal() {
+		return prev, nil
+	}
+
+	outcome := &Outcome{Key: item.Key(), ImpUID: item.ImpUID, MerchantUID: item.MerchantUID}
+
+	if err := r.wait(ctx); err != nil {
+		return nil, err
+	}
+
+	pay, err := r.find(ctx, item)
+	if err != nil {
+		return r.finish(ctx, reject(outcome, err))
+	}
+
+	outcome.ImpUID = pay.GetImpUid()
+	outcome.MerchantUID = pay.GetMerchantUid()
+
+	if !r.claim(outcome.ImpUID, outcome.Key) {
+		return r.finish(ctx, reject(outcome, fmt.Errorf("%s: %s", ErrDuplicateItem, outcome.ImpUID)))
+	}
+
+	outcome.RemainingBefore = iamport.Cancellable(pay)
+	outcome.Remaining = outcome.RemainingBefore
+
+	// 이전 실행에서 취소 요청 후 중단되었다면 잔액으로 처리 여부를 판단한다.
+	// 잔액이 요청 전과도, 요청 후 예상과도 다르면 다른 취소가 섞인 것이므로 확인이 필요하다.
+	if prev != nil && prev.Status == StatusPending && outcome.RemainingBefore != prev.RemainingBefore {
+		if outcome.RemainingBefore == prev.RemainingBefore-prev.Amount {
+			outcome.Status = StatusCancelled
+			outcome.Amount = prev.Amount
+			outcome.RemainingBefore = prev.RemainingBefore
+			return r.finish(ctx, outcome)
+		}
+
+		outcome.Status = StatusFailed
+		outcome.Amount = prev.Amount
+		outcome.Error = (&iamport.BalanceChangedError{
+			ImpUID:    outcome.ImpUID,
+			Expected:  prev.RemainingBefore,
+			Remaining: outcome.RemainingBefore,
+		}).Error()
+		return r.finish(ctx, outcome)
+	}
+
+	status := util.PaymentStatus(pay.GetStatus())
+	if status.IsCancelled() || (status.IsPaid() && outcome.RemainingBefore == 0) {
+		outcome.Status = StatusSkipped
+		return r.finish(ctx, outcome)
+	}
+
+	if !status.IsPaid() {
+		return r.finish(ctx, reject(outcome, errors.New(ErrNotPaid)))
+	}
+
+	outcome.Amount = item.Amount
+	if outcome.Amount == 0 {
+		outcome.Amount = outcome.RemainingBefore
+	}
+
+	switch {
+	case outcome.Amount < 0:
+		return r.finish(ctx, reject(outcome, errors.New(iamport.ErrInvalidAmount)))
+	case outcome.Amount > outcome.RemainingBefore:
+		return r.finish(ctx, reject(outcome, errors.New(iamport.ErrCancelAmountExceeded)))
+	case item.TaxFree < 0 || item.TaxFree > outcome.Amount:
+		return r.finish(ctx, reject(outcome, errors.New(iamport.ErrInvalidTaxFree)))
+	}
+
+	if r.opts.DryRun {
+		outcome.Status = StatusValid
+		return r.finish(ctx, outcome)
+	}
+
+	outcome.Status = StatusPending
+	if err := r.record(ctx, outcome); err != nil {
+		return nil, err
+	}
+
+	if err := r.wait(ctx); err != nil {
+		return nil, err
+	}
+
+	expected := outcome.RemainingBefore
+	cancelled, err := r.iamport.Payments.PartialCancel(outcome.ImpUID, iamport.PartialCancelRequest{
+		Amount:            outcome.Amount,
+		TaxFree:           item.TaxFree,
+		Reason:            item.Reason,
+		ExpectedRemaining: &expected,
+		Refund:            item.Refund,
+	})
+	if err != nil {
+		var changed *iamport.BalanceChangedError
+		if errors.As(err, &changed) {
+			outcome.Remaining = changed.Remaining
+		}
+
+		outcome.Status = StatusFailed
+		outcome.Error = err.Error()
+		return r.finish(ctx, outcome)
+	}
+
+	outcome.Status = StatusCancelled
+	outcome.Remaining = iamport.Cancellable(cancelled)
+	return r.finish(ctx, outcome)
+}
+
+// find 취소할 결제건을 가져온다.
+func (r *runner) find(ctx context.Context, item *Item) (*TypePayment.Payment, error) {
+	if item.ImpUID == "" {
+		return r.iamport.Payments.FindAuthoritative(ctx, item.MerchantUID)
+	}
+
+	pay, err := r.iamport.Payments.Get(item.ImpUID)
+	if err != nil {
+		return nil, err
+	}
+
+	if item.MerchantUID != "" && pay.GetMerchantUid() != item.MerchantUID {
+		return nil, errors.New(ErrMerchantUIDDiff)
+	}
+
+	return pay, nil
+}
+
+func (r *runner) wait(ctx context.Context) error {
+	if r.opts.Limiter != nil {
+		return r.opts.Limiter.Wait(ctx)
+	}
+
+	return ctx.Err()
+}
+
+// finish 결과를 기록하고 return 해준다.
+func (r *runner) finish(ctx context.Context, outcome *Outcome) (*Outcome, error) {
+	if err := r.record(ctx, outcome); err != nil {
+		return nil, err
+	}
+
+	return outcome, nil
+}
+
+func (r *runner) record(ctx context.Context, outcome *Outcome) error {
+	if r.opts.Journal == nil || r.opts.DryRun {
+		return nil
+	}
+
+	return r.opts.Journal.Record(ctx, outcome)
+}
+
+func reject(outcome *Outcome, err error) *Outcome {
+	outcome.Status = StatusRejected
+	outcome.Error = err.Error()
+	return outcome
+}
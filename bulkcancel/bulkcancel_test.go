@@ -0,0 +1,352 @@
+package bulkcancel
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	TypePayment "github.com/iamport/interface/gen_src/go/v1/payment"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/iamport/go-iamport/contract"
+	"github.com/iamport/go-iamport/export"
+	"github.com/iamport/go-iamport/iamport"
+	"github.com/iamport/go-iamport/util"
+)
+
+// paymentServer 결제건을 보관하고 조회, 취소 요청을 처리한다. checksum 이 잔액과 다르면 취소를 거절한다.
+type paymentServer struct {
+	mu       sync.Mutex
+	payments map[string]*TypePayment.Payment
+	cancels  int
+}
+
+func newPaymentServer() *paymentServer {
+	paid := func(impUID, muid string, amount int32) *TypePayment.Payment {
+		return &TypePayment.Payment{ImpUid: impUID, MerchantUid: muid, Amount: amount, Status: string(util.StatusPaid)}
+	}
+
+	return &paymentServer{payments: map[string]*TypePayment.Payment{
+		"imp_1": paid("imp_1", "order_1", 1000),
+		"imp_2": paid("imp_2", "order_2", 2000),
+		"imp_3": {ImpUid: "imp_3", MerchantUid: "order_3", Amount: 3000, CancelAmount: 3000, Status: string(util.StatusCancelled)},
+		"imp_4": {ImpUid: "imp_4", MerchantUid: "order_4", Amount: 4000, Status: string(util.StatusReady)},
+	}}
+}
+
+func (s *paymentServer) handle(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	switch {
+	case r.URL.Path == "/payments/cancel":
+		pay := s.payments[r.FormValue("imp_uid")]
+		amount, _ := strconv.Atoi(r.FormValue("amount"))
+		checksum, _ := strconv.Atoi(r.FormValue("checksum"))
+		if int32(checksum) != pay.Amount-pay.CancelAmount {
+			contract.RespondError(w, "취소 가능 잔액 검증에 실패하였습니다.")
+			return
+		}
+
+		s.cancel(pay, int32(amount))
+		contract.Respond(w, pay)
+	case strings.HasPrefix(r.URL.Path, "/payments/findAll/"):
+		muid := strings.Split(strings.TrimPrefix(r.URL.Path, "/payments/findAll/"), "/")[0]
+		page := &TypePayment.PaymentPage{}
+		for _, pay := range s.payments {
+			if pay.MerchantUid == muid {
+				page.List = append(page.List, pay)
+			}
+		}
+		if len(page.List) == 0 {
+			w.WriteHeader(http.StatusNotFound)
+		}
+		contract.Respond(w, page)
+	default:
+		pay, ok := s.payments[strings.TrimPrefix(r.URL.Path, "/payments/")]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			contract.RespondError(w, "존재하지 않는 결제정보입니다.")
+			return
+		}
+		contract.Respond(w, pay)
+	}
+}
+
+func (s *paymentServer) cancel(pay *TypePayment.Payment, amount int32) {
+	s.cancels++
+	pay.CancelAmount += amount
+	pay.CancelHistory = append(pay.CancelHistory, &TypePayment.CancelHistory{Amount: amount})
+	if pay.CancelAmount == pay.Amount {
+		pay.Status = string(util.StatusCancelled)
+	}
+}
+
+func newBulkIamport(t *testing.T) (*iamport.Iamport, *paymentServer) {
+	payments := newPaymentServer()
+	server := contract.NewServer(payments.handle)
+	t.Cleanup(server.Close)
+
+	auth, err := server.Authenticate()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return iamport.NewIamportWithAuthenticate(auth), payments
+}
+
+type countingLimiter struct {
+	mu    sync.Mutex
+	calls int
+}
+
+func (l *countingLimiter) Wait(ctx context.Context) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.calls++
+	return nil
+}
+
+var bulkItems = []*Item{
+	{ImpUID: "imp_1", Amount: 300, Reason: "리콜"},
+	{MerchantUID: "order_2"},
+	{ImpUID: "imp_3"},
+	{ImpUID: "imp_4"},
+	{ImpUID: "imp_1x"},
+	{ImpUID: "imp_2", MerchantUID: "order_1"},
+}
+
+func statuses(report *Report) map[string]Status {
+	result := map[string]Status{}
+	for _, o := range report.Outcomes {
+		result[o.Key] = o.Status
+	}
+	return result
+}
+
+func TestRunDryRun(t *testing.T) {
+	iam, payments := newBulkIamport(t)
+
+	report, err := Run(context.Background(), iam, bulkItems, &Options{DryRun: true})
+	assert.NoError(t, err)
+	assert.True(t, report.DryRun)
+	assert.Equal(t, map[string]Status{
+		"imp_1":                StatusValid,
+		"merchant_uid:order_2": StatusValid,
+		"imp_3":                StatusSkipped,
+		"imp_4":                StatusRejected,
+		"imp_1x":               StatusRejected,
+		"imp_2":                StatusRejected,
+	}, statuses(report))
+	assert.Equal(t, 0, payments.cancels)
+
+	assert.Equal(t, int32(2000), report.Outcomes[1].Amount)
+	assert.Equal(t, ErrNotPaid, report.Outcomes[3].Error)
+	assert.Equal(t, util.ErrStatusNotFound, report.Outcomes[4].Error)
+	assert.Equal(t, ErrMerchantUIDDiff, report.Outcomes[5].Error)
+}
+
+func TestRun(t *testing.T) {
+	iam, payments := newBulkIamport(t)
+	limiter := &countingLimiter{}
+
+	var mu sync.Mutex
+	var notified int
+	report, err := Run(context.Background(), iam, bulkItems, &Options{
+		Concurrency: 3,
+		Limiter:     limiter,
+		OnOutcome: func(*Outcome) {
+			mu.Lock()
+			notified++
+			mu.Unlock()
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, payments.cancels)
+	assert.Equal(t, 6, notified)
+	// 결제건마다 조회 1번, 취소 2건은 취소 1번씩
+	assert.Equal(t, 8, limiter.calls)
+
+	assert.Equal(t, map[Status]int{StatusCancelled: 2, StatusSkipped: 1, StatusRejected: 3}, report.Count())
+	assert.Equal(t, int64(2300), report.CancelledAmount())
+	assert.Equal(t, &Outcome{Key: "imp_1", ImpUID: "imp_1", MerchantUID: "order_1", Status: StatusCancelled,
+		Amount: 300, RemainingBefore: 1000, Remaining: 700}, report.Outcomes[0])
+	assert.Equal(t, int32(700), payments.payments["imp_1"].Amount-payments.payments["imp_1"].CancelAmount)
+}
+
+func TestRunDuplicateItem(t *testing.T) {
+	iam, _ := newBulkIamport(t)
+
+	_, err := Run(context.Background(), iam, []*Item{{ImpUID: "imp_1"}, {ImpUID: "imp_1"}}, nil)
+	assert.EqualError(t, err, ErrDuplicateItem+": imp_1")
+
+	_, err = Run(context.Background(), iam, []*Item{{}}, nil)
+	assert.EqualError(t, err, iamport.ErrMustExistImpUIDorMerchantUID)
+}
+
+func TestRunDuplicateImpUID(t *testing.T) {
+	iam, payments := newBulkIamport(t)
+
+	// order_1 은 imp_1 의 merchant_uid 이므로 같은 결제건이다.
+	items := []*Item{{ImpUID: "imp_1"}, {MerchantUID: "order_1"}}
+	report, err := Run(context.Background(), iam, items, &Options{Concurrency: 2})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, payments.cancels)
+	assert.Equal(t, map[Status]int{StatusCancelled: 1, StatusRejected: 1}, report.Count())
+
+	for _, outcome := range report.Outcomes {
+		if outcome.Status == StatusRejected {
+			assert.Equal(t, ErrDuplicateItem+": imp_1", outcome.Error)
+		}
+	}
+}
+
+func TestRunDuplicateImpUIDAfterResume(t *testing.T) {
+	iam, payments := newBulkIamport(t)
+	path := filepath.Join(t.TempDir(), "journal.jsonl")
+
+	journal, err := OpenFileJournal(path)
+	assert.NoError(t, err)
+	defer journal.Close()
+
+	// 이전 실행에서 merchant_uid 로 imp_1 을 전액 취소하였다.
+	_, err = Run(context.Background(), iam, []*Item{{MerchantUID: "order_1", Amount: 300}}, &Options{Journal: journal})
+	assert.NoError(t, err)
+
+	report, err := Run(context.Background(), iam, []*Item{{MerchantUID: "order_1", Amount: 300}, {ImpUID: "imp_1", Amount: 300}}, &Options{Journal: journal})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, payments.cancels)
+	assert.Equal(t, StatusCancelled, report.Outcomes[0].Status)
+	assert.Equal(t, StatusRejected, report.Outcomes[1].Status)
+	assert.Equal(t, ErrDuplicateItem+": imp_1", report.Outcomes[1].Error)
+}
+
+func TestRunResume(t *testing.T) {
+	iam, payments := newBulkIamport(t)
+	path := filepath.Join(t.TempDir(), "journal.jsonl")
+
+	journal, err := OpenFileJournal(path)
+	assert.NoError(t, err)
+
+	// imp_1 은 이전 실행에서 취소 요청 후 결과를 기록하기 전에 중단되었고, 요청은 처리되었다.
+	assert.NoError(t, journal.Record(context.Background(), &Outcome{Key: "imp_1", ImpUID: "imp_1", Status: StatusPending, Amount: 300, RemainingBefore: 1000}))
+	payments.cancel(payments.payments["imp_1"], 300)
+	// order_2 는 이전 실행에서 취소되었다.
+	assert.NoError(t, journal.Record(context.Background(), &Outcome{Key: "merchant_uid:order_2", Status: StatusCancelled, Amount: 2000, RemainingBefore: 2000}))
+	assert.NoError(t, journal.Close())
+
+	journal, err = OpenFileJournal(path)
+	assert.NoError(t, err)
+	defer journal.Close()
+
+	report, err := Run(context.Background(), iam, bulkItems[:2], &Options{Journal: journal})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, payments.cancels)
+	assert.Equal(t, &Outcome{Key: "imp_1", ImpUID: "imp_1", MerchantUID: "order_1", Status: StatusCancelled,
+		Amount: 300, RemainingBefore: 1000, Remaining: 700}, report.Outcomes[0])
+	assert.Equal(t, StatusCancelled, report.Outcomes[1].Status)
+
+	recorded, err := journal.Load(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, StatusCancelled, recorded["imp_1"].Status)
+}
+
+// crash 기록 도중 중단된 것처럼 기록 파일에 잘린 줄을 남기고 닫는다.
+func crash(t *testing.T, journal *FileJournal, path string) {
+	assert.NoError(t, journal.Close())
+
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND, 0600)
+	assert.NoError(t, err)
+	_, err = file.WriteString(`{"key":"imp_crashed","sta`)
+	assert.NoError(t, err)
+	assert.NoError(t, file.Close())
+}
+
+func TestRunResumeAfterRepeatedCrash(t *testing.T) {
+	iam, payments := newBulkIamport(t)
+	path := filepath.Join(t.TempDir(), "journal.jsonl")
+
+	journal, err := OpenFileJournal(path)
+	assert.NoError(t, err)
+	_, err = Run(context.Background(), iam, bulkItems[:1], &Options{Journal: journal})
+	assert.NoError(t, err)
+	crash(t, journal, path)
+
+	journal, err = OpenFileJournal(path)
+	assert.NoError(t, err)
+	_, err = Run(context.Background(), iam, bulkItems[:2], &Options{Journal: journal})
+	assert.NoError(t, err)
+	crash(t, journal, path)
+
+	journal, err = OpenFileJournal(path)
+	assert.NoError(t, err)
+	defer journal.Close()
+
+	report, err := Run(context.Background(), iam, bulkItems[:2], &Options{Journal: journal})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, payments.cancels)
+	assert.Equal(t, map[Status]int{StatusCancelled: 2}, report.Count())
+
+	body, err := ioutil.ReadFile(path)
+	assert.NoError(t, err)
+	assert.True(t, bytes.HasSuffix(body, []byte("\n")))
+	assert.NotContains(t, string(body), "imp_crashed")
+}
+
+func TestRunResumeBalanceChanged(t *testing.T) {
+	iam, payments := newBulkIamport(t)
+	path := filepath.Join(t.TempDir(), "journal.jsonl")
+
+	journal, err := OpenFileJournal(path)
+	assert.NoError(t, err)
+	defer journal.Close()
+
+	// 중단된 뒤 다른 곳에서 다른 금액이 취소되었다.
+	assert.NoError(t, journal.Record(context.Background(), &Outcome{Key: "imp_1", Status: StatusPending, Amount: 300, RemainingBefore: 1000}))
+	payments.cancel(payments.payments["imp_1"], 100)
+
+	report, err := Run(context.Background(), iam, bulkItems[:1], &Options{Journal: journal})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, payments.cancels)
+	assert.Equal(t, StatusFailed, report.Outcomes[0].Status)
+	assert.Equal(t, "iamport: cancellable amount of imp_1 changed: expected 1000, remaining 900", report.Outcomes[0].Error)
+}
+
+func TestReadCSV(t *testing.T) {
+	items, err := ReadCSV(strings.NewReader(string(export.UTF8BOM) + "imp_uid, amount,reason,refund_bank\nimp_1,300,리콜,004\nimp_2,,,\n"))
+	assert.NoError(t, err)
+	assert.Len(t, items, 2)
+	assert.Equal(t, &Item{ImpUID: "imp_1", Amount: 300, Reason: "리콜", Refund: iamport.RefundAccount{Bank: "004"}}, items[0])
+	assert.Equal(t, &Item{ImpUID: "imp_2"}, items[1])
+
+	_, err = ReadCSV(strings.NewReader("amount\n300\n"))
+	assert.EqualError(t, err, ErrInvalidItemCSV+": imp_uid or merchant_uid column is required")
+
+	_, err = ReadCSV(strings.NewReader("imp_uid,price\nimp_1,300\n"))
+	assert.EqualError(t, err, ErrInvalidItemCSV+`: unknown column "price"`)
+
+	_, err = ReadCSV(strings.NewReader("imp_uid,amount\nimp_1,abc\n"))
+	assert.Contains(t, err.Error(), "line 2: amount")
+}
+
+func TestReportWriteCSV(t *testing.T) {
+	report := &Report{Outcomes: []*Outcome{
+		{Key: "imp_1", ImpUID: "imp_1", Status: StatusFailed, Amount: 300, RemainingBefore: 1000, Remaining: 1000, Error: "-x"},
+	}}
+
+	var buf bytes.Buffer
+	assert.NoError(t, report.WriteCSV(&buf))
+	assert.Equal(t, "key,imp_uid,merchant_uid,status,amount,remaining_before,remaining,error\nimp_1,imp_1,,failed,300,1000,1000,'-x\n",
+		string(buf.Bytes()[len(export.UTF8BOM):]))
+
+	buf.Reset()
+	assert.NoError(t, report.WriteJSONL(&buf))
+	assert.Equal(t, `{"key":"imp_1","imp_uid":"imp_1","status":"failed","amount":300,"remaining_before":1000,"remaining":1000,"error":"-x"}`+"\n", buf.String())
+}
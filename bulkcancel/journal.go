@@ -0,0 +1,86 @@
+package bulkcancel
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+)
+
+// FileJournal 결과를 JSON Lines 파일에 이어서 기록하는 Journal
+// 같은 Key 가 여러 번 기록되면 마지막 기록을 사용한다.
+type FileJournal struct {
+	mu   sync.Mutex
+	file *os.File
+}
+
+// OpenFileJournal path 의 기록 파일을 연다. 없으면 새로 만든다.
+func OpenFileJournal(path string) (*FileJournal, error) {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_RDWR|os.O_APPEND, 0600)
+	if err != nil {
+		return nil, err
+	}
+
+	return &FileJournal{file: file}, nil
+}
+
+// Load 기록 파일 전체를 읽는다.
+// 기록 도중 중단되어 마지막 줄이 잘린 경우 해당 줄을 파일에서 잘라내어 다음 기록이 새 줄에서 시작되도록 한다.
+func (j *FileJournal) Load(ctx context.Context) (map[string]*Outcome, error) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	if _, err := j.file.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+
+	outcomes := map[string]*Outcome{}
+	reader := bufio.NewReader(j.file)
+	var offset int64
+	for line := 1; ; line++ {
+		body, err := reader.ReadBytes('\n')
+		if err == io.EOF {
+			if len(body) > 0 {
+				if err := j.file.Truncate(offset); err != nil {
+					return nil, err
+				}
+			}
+			return outcomes, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		offset += int64(len(body))
+
+		outcome := &Outcome{}
+		if err := json.Unmarshal(body, outcome); err != nil {
+			return nil, fmt.Errorf("iamport: invalid bulk cancel journal line %d: %v", line, err)
+		}
+		outcomes[outcome.Key] = outcome
+	}
+}
+
+// Record 결과 한 줄을 기록하고 디스크에 반영한다.
+func (j *FileJournal) Record(ctx context.Context, outcome *Outcome) error {
+	body, err := json.Marshal(outcome)
+	if err != nil {
+		return err
+	}
+
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	if _, err := j.file.Write(append(body, '\n')); err != nil {
+		return err
+	}
+
+	return j.file.Sync()
+}
+
+// Close 기록 파일을 닫는다.
+func (j *FileJournal) Close() error {
+	return j.file.Close()
+}
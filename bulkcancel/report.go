@@ -0,0 +1,173 @@
+package bulkcancel
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/iamport/go-iamport/export"
+)
+
+const ErrInvalidItemCSV = "iamport: invalid bulk cancel csv"
+
+// itemColumns ReadCSV 가 인식하는 헤더
+var itemColumns = []string{"imp_uid", "merchant_uid", "amount", "tax_free", "reason", "refund_holder", "refund_bank", "refund_account"}
+
+// ReadCSV 헤더가 있는 CSV 에서 취소할 결제건을 읽는다.
+//
+// 헤더는 imp_uid, merchant_uid, amount, tax_free, reason, refund_holder, refund_bank, refund_account 중
+// 필요한 것만 순서에 상관없이 적으며, imp_uid 또는 merchant_uid 는 반드시 있어야 한다.
+// amount 가 비어있으면 취소 가능 잔액 전부를 취소한다.
+func ReadCSV(r io.Reader) ([]*Item, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", ErrInvalidItemCSV, err)
+	}
+
+	index := map[string]int{}
+	for i, name := range header {
+		name = strings.TrimSpace(strings.TrimPrefix(name, string(export.UTF8BOM)))
+		if !contains(itemColumns, name) {
+			return nil, fmt.Errorf("%s: unknown column %q", ErrInvalidItemCSV, name)
+		}
+		index[name] = i
+	}
+
+	_, hasImpUID := index["imp_uid"]
+	_, hasMerchantUID := index["merchant_uid"]
+	if !hasImpUID && !hasMerchantUID {
+		return nil, fmt.Errorf("%s: imp_uid or merchant_uid column is required", ErrInvalidItemCSV)
+	}
+
+	var items []*Item
+	for line := 2; ; line++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			return items, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", ErrInvalidItemCSV, err)
+		}
+
+		field := func(name string) string {
+			if i, ok := index[name]; ok {
+				return strings.TrimSpace(record[i])
+			}
+			return ""
+		}
+
+		item := &Item{
+			ImpUID:      field("imp_uid"),
+			MerchantUID: field("merchant_uid"),
+			Reason:      field("reason"),
+		}
+		item.Refund.Holder = field("refund_holder")
+		item.Refund.Bank = field("refund_bank")
+		item.Refund.Account = field("refund_account")
+
+		if item.Amount, err = parseAmount(field("amount")); err != nil {
+			return nil, fmt.Errorf("%s: line %d: amount: %v", ErrInvalidItemCSV, line, err)
+		}
+		if item.TaxFree, err = parseAmount(field("tax_free")); err != nil {
+			return nil, fmt.Errorf("%s: line %d: tax_free: %v", ErrInvalidItemCSV, line, err)
+		}
+
+		items = append(items, item)
+	}
+}
+
+func parseAmount(src string) (int32, error) {
+	if src == "" {
+		return 0, nil
+	}
+
+	amount, err := strconv.ParseInt(src, 10, 32)
+	return int32(amount), err
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Report 일괄 취소 결과 (items 순서)
+// 중단된 경우 처리하지 못한 결제건은 포함되지 않는다.
+type Report struct {
+	DryRun   bool       `json:"dry_run"`
+	Outcomes []*Outcome `json:"outcomes"`
+}
+
+// Count 처리 결과별 건수
+func (r *Report) Count() map[Status]int {
+	counts := map[Status]int{}
+	for _, outcome := range r.Outcomes {
+		counts[outcome.Status]++
+	}
+
+	return counts
+}
+
+// CancelledAmount 취소된 금액 합계
+func (r *Report) CancelledAmount() int64 {
+	var total int64
+	for _, outcome := range r.Outcomes {
+		if outcome.Status == StatusCancelled {
+			total += int64(outcome.Amount)
+		}
+	}
+
+	return total
+}
+
+// WriteJSONL 결제건별 처리 결과를 한 줄에 하나씩 JSON 으로 기록한다.
+func (r *Report) WriteJSONL(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	for _, outcome := range r.Outcomes {
+		if err := encoder.Encode(outcome); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// WriteCSV 결제건별 처리 결과를 엑셀에서 열 수 있는 CSV 로 기록한다.
+func (r *Report) WriteCSV(w io.Writer) error {
+	if _, err := w.Write(export.UTF8BOM); err != nil {
+		return err
+	}
+
+	writer := csv.NewWriter(w)
+	if err := writer.Write([]string{"key", "imp_uid", "merchant_uid", "status", "amount", "remaining_before", "remaining", "error"}); err != nil {
+		return err
+	}
+
+	for _, o := range r.Outcomes {
+		if err := writer.Write([]string{
+			export.EscapeFormula(o.Key),
+			export.EscapeFormula(o.ImpUID),
+			export.EscapeFormula(o.MerchantUID),
+			string(o.Status),
+			strconv.Itoa(int(o.Amount)),
+			strconv.Itoa(int(o.RemainingBefore)),
+			strconv.Itoa(int(o.Remaining)),
+			export.EscapeFormula(o.Error),
+		}); err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
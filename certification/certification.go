@@ -0,0 +1,190 @@
+package certification
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/iamport/go-iamport/util"
+)
+
+const (
+	URLCertifications = "/certifications"
+
+	// BirthdayLayout 아임포트 birthday 필드 형식
+	BirthdayLayout = "2006-01-02"
+)
+
+// Gender 성별
+type Gender string
+
+const (
+	GenderMale   Gender = "male"
+	GenderFemale Gender = "female"
+)
+
+// Carrier 통신사
+type Carrier string
+
+const (
+	CarrierSKT     Carrier = "SKT"
+	CarrierKT      Carrier = "KT"
+	CarrierLGT     Carrier = "LGT"
+	CarrierSKTMVNO Carrier = "SKT_MVNO" // SKT 알뜰폰
+	CarrierKTMVNO  Carrier = "KT_MVNO"  // KT 알뜰폰
+	CarrierLGTMVNO Carrier = "LGT_MVNO" // LGU+ 알뜰폰
+)
+
+// IsMVNO 알뜰폰인지 확인한다.
+func (c Carrier) IsMVNO() bool {
+	return strings.HasSuffix(string(c), "_MVNO")
+}
+
+// Certification 본인인증 결과
+// interface 모듈에 certifications 메시지가 없어 아임포트 응답을 직접 변환한다.
+type Certification struct {
+	ImpUID      string
+	MerchantUID string
+	PgTid       string
+	PgProvider  string
+
+	Name        string
+	Gender      Gender
+	Birth       time.Time // 생년월일 (KST 0시)
+	Foreigner   bool      // 외국인 여부
+	Phone       string
+	Carrier     Carrier
+	Certified   bool      // 인증 완료 여부
+	CertifiedAt time.Time // 인증 완료 시각
+	CI          string    // 연계정보 (unique_key), 서비스와 무관하게 개인마다 고유
+	DI          string    // 중복가입확인정보 (unique_in_site), 가맹점 내에서 개인마다 고유
+	Origin      string    // 본인인증을 요청한 페이지 URL
+}
+
+type certificationJSON struct {
+	ImpUID       string `json:"imp_uid"`
+	MerchantUID  string `json:"merchant_uid"`
+	PgTid        string `json:"pg_tid"`
+	PgProvider   string `json:"pg_provider"`
+	Name         string `json:"name"`
+	Gender       string `json:"gender"`
+	Birth        int64  `json:"birth"`
+	Birthday     string `json:"birthday"`
+	Foreigner    bool   `json:"foreigner"`
+	Phone        string `json:"phone"`
+	Carrier      string `json:"carrier"`
+	Certified    bool   `json:"certified"`
+	CertifiedAt  int64  `json:"certified_at"`
+	UniqueKey    string `json:"unique_key"`
+	UniqueInSite string `json:"unique_in_site"`
+	Origin       string `json:"origin"`
+}
+
+// UnmarshalJSON 아임포트 응답을 변환한다.
+// 생년월일은 birthday(YYYY-MM-DD) 를 우선 사용하고, 없으면 birth(unix timestamp) 를 사용한다.
+func (c *Certification) UnmarshalJSON(data []byte) error {
+	raw := certificationJSON{}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	*c = Certification{
+		ImpUID:      raw.ImpUID,
+		MerchantUID: raw.MerchantUID,
+		PgTid:       raw.PgTid,
+		PgProvider:  raw.PgProvider,
+		Name:        raw.Name,
+		Gender:      Gender(raw.Gender),
+		Foreigner:   raw.Foreigner,
+		Phone:       raw.Phone,
+		Carrier:     Carrier(raw.Carrier),
+		Certified:   raw.Certified,
+		CI:          raw.UniqueKey,
+		DI:          raw.UniqueInSite,
+		Origin:      raw.Origin,
+	}
+
+	switch {
+	case raw.Birthday != "":
+		birth, err := time.ParseInLocation(BirthdayLayout, raw.Birthday, util.KST)
+		if err != nil {
+			return err
+		}
+		c.Birth = birth
+	case raw.Birth != 0:
+		birth := time.Unix(raw.Birth, 0).In(util.KST)
+		c.Birth = time.Date(birth.Year(), birth.Month(), birth.Day(), 0, 0, 0, 0, util.KST)
+	}
+
+	if raw.CertifiedAt != 0 {
+		c.CertifiedAt = time.Unix(raw.CertifiedAt, 0)
+	}
+
+	return nil
+}
+
+// Age now 기준 만 나이 (KST). 생년월일이 없으면 -1
+func (c *Certification) Age(now time.Time) int {
+	if c.Birth.IsZero() {
+		return -1
+	}
+
+	now = now.In(util.KST)
+	birth := c.Birth.In(util.KST)
+
+	age := now.Year() - birth.Year()
+	if now.Month() < birth.Month() || (now.Month() == birth.Month() && now.Day() < birth.Day()) {
+		age--
+	}
+
+	return age
+}
+
+// IsAtLeast now 기준 만 years 세 이상인지 확인한다.
+func (c *Certification) IsAtLeast(years int, now time.Time) bool {
+	return c.Age(now) >= years
+}
+
+// CertificationRequest 본인인증 결과 조회, 삭제 요청
+type CertificationRequest struct {
+	ImpUID string
+}
+
+// CertificationResponse 본인인증 결과 응답
+type CertificationResponse struct {
+	Code     int32          `json:"code"`
+	Message  string         `json:"message"`
+	Response *Certification `json:"response"`
+}
+
+// GetByImpUID - GET /certifications/{imp_uid}
+// 아임포트 고유번호로 본인인증 결과를 조회합니다.
+func GetByImpUID(client *http.Client, apiDomain string, token string, params *CertificationRequest) (*CertificationResponse, error) {
+	urlCertification := strings.Join([]string{apiDomain, URLCertifications, "/", params.ImpUID}, "")
+
+	return call(client, token, urlCertification, util.GET)
+}
+
+// Delete - DELETE /certifications/{imp_uid}
+// 아임포트에 저장된 본인인증 결과(개인정보)를 삭제합니다.
+func Delete(client *http.Client, apiDomain string, token string, params *CertificationRequest) (*CertificationResponse, error) {
+	urlCertification := strings.Join([]string{apiDomain, URLCertifications, "/", params.ImpUID}, "")
+
+	return call(client, token, urlCertification, util.DELETE)
+}
+
+func call(client *http.Client, token string, url string, method util.Method) (*CertificationResponse, error) {
+	res, err := util.Call(client, token, url, method)
+	if err != nil {
+		return nil, err
+	}
+
+	certificationRes := CertificationResponse{}
+	err = json.Unmarshal(res, &certificationRes)
+	if err != nil {
+		return nil, err
+	}
+
+	return &certificationRes, nil
+}
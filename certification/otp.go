@@ -0,0 +1,94 @@
+package certification
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/iamport/go-iamport/util"
+)
+
+const (
+	URLOTP     = "/otp"
+	URLRequest = "/request"
+	URLConfirm = "/confirm"
+)
+
+// OTPRequest SMS 본인인증 요청
+type OTPRequest struct {
+	Name        string `json:"name"`
+	Phone       string `json:"phone"`
+	Birth       string `json:"birth"`        // 생년월일 (YYYYMMDD)
+	GenderDigit string `json:"gender_digit"` // 주민등록번호 뒷자리 첫 번째 숫자
+	Carrier     string `json:"carrier"`      // 통신사 (SKT, KTF, LGT)
+	IsMVNO      bool   `json:"is_mvno"`      // 알뜰폰 여부
+	Company     string `json:"company,omitempty"`
+	Pg          string `json:"pg,omitempty"`
+	MerchantUID string `json:"merchant_uid,omitempty"`
+}
+
+// OTPRequestResponse SMS 본인인증 요청 응답
+type OTPRequestResponse struct {
+	Code     int32         `json:"code"`
+	Message  string        `json:"message"`
+	Response *OTPRequested `json:"response"`
+}
+
+// OTPRequested 발송된 본인인증 요청
+type OTPRequested struct {
+	ImpUID string `json:"imp_uid"`
+}
+
+// OTPConfirmRequest SMS 인증번호 확인 요청
+type OTPConfirmRequest struct {
+	ImpUID string `json:"-"`
+	OTP    string `json:"otp"`
+}
+
+// RequestOTP - POST /certifications/otp/request
+// 본인인증 정보를 전달하여 휴대폰으로 SMS 인증번호를 발송합니다.
+func RequestOTP(client *http.Client, apiDomain string, token string, params *OTPRequest) (*OTPRequestResponse, error) {
+	urlRequest := strings.Join([]string{apiDomain, URLCertifications, URLOTP, URLRequest}, "")
+
+	jsonBytes, err := json.Marshal(params)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := util.CallWithJson(client, token, urlRequest, util.POST, jsonBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	requestRes := OTPRequestResponse{}
+	err = json.Unmarshal(res, &requestRes)
+	if err != nil {
+		return nil, err
+	}
+
+	return &requestRes, nil
+}
+
+// ConfirmOTP - POST /certifications/otp/confirm/{imp_uid}
+// 사용자가 입력한 SMS 인증번호를 확인하여 본인인증을 완료합니다.
+func ConfirmOTP(client *http.Client, apiDomain string, token string, params *OTPConfirmRequest) (*CertificationResponse, error) {
+	urlConfirm := strings.Join([]string{apiDomain, URLCertifications, URLOTP, URLConfirm, "/", params.ImpUID}, "")
+
+	jsonBytes, err := json.Marshal(params)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := util.CallWithJson(client, token, urlConfirm, util.POST, jsonBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	certificationRes := CertificationResponse{}
+	err = json.Unmarshal(res, &certificationRes)
+	if err != nil {
+		return nil, err
+	}
+
+	return &certificationRes, nil
+}
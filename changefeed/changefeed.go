@@ -0,0 +1,271 @@
+package changefeed
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"time"
+
+	TypePayment "github.com/iamport/interface/gen_src/go/v1/payment"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/iamport/go-iamport/iamport"
+	"github.com/iamport/go-iamport/refund"
+	"github.com/iamport/go-iamport/util"
+)
+
+// EventType 결제건 변경 종류
+type EventType string
+
+const (
+	EventNew                EventType = "new"                 // 미결제 (결제창 진입, 가상계좌 발급 등)
+	EventPaid               EventType = "paid"                // 결제완료
+	EventPartiallyCancelled EventType = "partially_cancelled" // 부분 취소
+	EventCancelled          EventType = "cancelled"           // 전액 취소
+	EventFailed             EventType = "failed"              // 결제실패
+)
+
+// Event 결제건 변경 이벤트
+type Event struct {
+	Type      EventType
+	Payment   *TypePayment.Payment
+	UpdatedAt time.Time
+}
+
+// DefaultOverlap Poller.Overlap 기본값
+const DefaultOverlap = time.Minute
+
+// Key 이벤트 식별자 (imp_uid@최종수정시각)
+// 최종수정시각은 결제 정보로 추정하므로 시각이 바뀌지 않는 변경은 같은 Key 로 다시 전달될 수 있다.
+func (e *Event) Key() string {
+	return fmt.Sprintf("%s@%d", e.Payment.GetImpUid(), e.UpdatedAt.Unix())
+}
+
+// Checkpoint 어디까지 처리했는지 기록한 위치
+type Checkpoint struct {
+	Cursor int64         `json:"cursor"` // 마지막으로 조회를 마친 구간의 끝 (아임포트 최종수정시각 기준)
+	Seen   []SeenPayment `json:"seen"`   // 다음 Poll 과 겹쳐 조회되는 구간에서 전달한 결제건
+}
+
+// SeenPayment 전달한 결제건
+type SeenPayment struct {
+	ImpUID string `json:"imp_uid"`
+	Digest string `json:"digest"` // 전달한 결제 정보의 digest
+	Cursor int64  `json:"cursor"` // 전달한 조회 구간의 끝
+}
+
+// CheckpointStore Checkpoint 저장소
+type CheckpointStore interface {
+	// Load 저장된 Checkpoint 를 가져온다. 저장된 것이 없으면 nil, nil
+	Load(ctx context.Context) (*Checkpoint, error)
+	Save(ctx context.Context, checkpoint *Checkpoint) error
+}
+
+// Handler 변경 이벤트 처리 함수. 오류를 return 하면 해당 이벤트부터 다음 Poll 에서 다시 전달된다.
+type Handler func(ctx context.Context, event *Event) error
+
+// Poller 최종수정시각(updated) 순으로 결제건을 조회하여 변경 이벤트를 전달한다.
+// 웹훅을 놓친 경우에도 모든 변경을 한 번 이상 전달받을 수 있다.
+//
+// 아임포트는 updated 정렬 시 from/to 도 최종수정시각 기준으로 적용한다.
+// 결제 정보에는 아임포트의 최종수정시각이 없으므로 이전 Poll 의 조회 구간 끝부터 Overlap 만큼 겹쳐 조회하고,
+// 겹친 구간에서 이미 전달한 결제건은 imp_uid 와 결제 정보가 같으면 다시 전달하지 않는다.
+type Poller struct {
+	iamport *iamport.Iamport
+	store   CheckpointStore
+
+	// Start 저장된 Checkpoint 가 없을 때 조회를 시작할 시각. 비어있으면 현재 시각 기준 3개월 전
+	Start time.Time
+	// Overlap 이전 Poll 의 조회 구간과 겹쳐 조회할 시간. 비어있으면 DefaultOverlap
+	Overlap time.Duration
+	// Limiter 가 있으면 매 페이지 요청 전에 Wait 을 호출한다.
+	Limiter iamport.Limiter
+
+	now func() time.Time
+}
+
+// NewPoller Poller 를 만든다.
+func NewPoller(iam *iamport.Iamport, store CheckpointStore) *Poller {
+	return &Poller{
+		iamport: iam,
+		store:   store,
+		now:     time.Now,
+	}
+}
+
+// Poll Checkpoint 이후 변경된 결제건을 최종수정시각 순으로 handler 에 전달하고, 처리할 때마다 Checkpoint 를 저장한다.
+// 전달한 이벤트 수를 return 해준다.
+//
+// Checkpoint 가 3개월보다 오래되었으면 3개월 구간씩 나누어 현재 시각까지 따라잡는다.
+func (p *Poller) Poll(ctx context.Context, handler Handler) (int, error) {
+	checkpoint, err := p.store.Load(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	overlap := p.Overlap
+	if overlap <= 0 {
+		overlap = DefaultOverlap
+	}
+
+	now := p.now()
+	var from time.Time
+	if checkpoint == nil {
+		from = p.Start
+		if from.IsZero() {
+			from = iamport.ListPeriodStart(now)
+		}
+		checkpoint = &Checkpoint{}
+	} else {
+		from = time.Unix(checkpoint.Cursor, 0).Add(-overlap)
+	}
+
+	emitted := 0
+	for _, period := range iamport.SplitPeriod(from, now) {
+		payments, err := p.list(ctx, period)
+		if err != nil {
+			return emitted, err
+		}
+
+		for _, pay := range payments {
+			digest := paymentDigest(pay)
+			if !checkpoint.isNew(pay, digest) {
+				continue
+			}
+
+			event := &Event{
+				Type:      Classify(pay),
+				Payment:   pay,
+				UpdatedAt: time.Unix(int64(iamport.UpdatedAt(pay)), 0),
+			}
+			if err := handler(ctx, event); err != nil {
+				return emitted, err
+			}
+			emitted++
+
+			checkpoint.see(pay, digest, period.To.Unix())
+			if err := p.store.Save(ctx, checkpoint); err != nil {
+				return emitted, err
+			}
+		}
+
+		checkpoint.advance(period.To.Unix(), period.To.Add(-overlap).Unix())
+		if err := p.store.Save(ctx, checkpoint); err != nil {
+			return emitted, err
+		}
+	}
+
+	return emitted, nil
+}
+
+// Run interval 마다 Poll 을 반복한다. ctx 가 끝나거나 Poll 이 실패하면 멈춘다.
+func (p *Poller) Run(ctx context.Context, interval time.Duration, handler Handler) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := p.Poll(ctx, handler); err != nil {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// list 구간의 모든 결제건을 최종수정시각 순으로 가져온다.
+func (p *Poller) list(ctx context.Context, period iamport.Period) ([]*TypePayment.Payment, error) {
+	var payments []*TypePayment.Payment
+
+	it := p.iamport.Payments.Iterate(ctx, &iamport.ListOptions{
+		Status:  util.StatusAll,
+		From:    period.From,
+		To:      period.To,
+		Sorting: util.SortASCUpdated,
+		Limiter: p.Limiter,
+	})
+	for it.Next() {
+		payments = append(payments, it.Payment())
+	}
+
+	if err := it.Err(); err != nil {
+		return nil, err
+	}
+
+	// 최종수정시각을 결제 정보로 추정하므로 아임포트 정렬과 다를 수 있어 다시 정렬한다.
+	sort.SliceStable(payments, func(i, j int) bool {
+		return iamport.UpdatedAt(payments[i]) < iamport.UpdatedAt(payments[j])
+	})
+
+	return payments, nil
+}
+
+// Classify 결제 정보로 변경 종류를 판단한다.
+func Classify(pay *TypePayment.Payment) EventType {
+	switch util.PaymentStatus(pay.GetStatus()) {
+	case util.StatusPaid:
+		if refund.Summarize(pay).IsPartiallyCancelled() {
+			return EventPartiallyCancelled
+		}
+		return EventPaid
+	case util.StatusCancelled:
+		return EventCancelled
+	case util.StatusFailed:
+		return EventFailed
+	default:
+		return EventNew
+	}
+}
+
+// paymentDigest 결제 정보가 바뀌었는지 비교하기 위한 digest
+func paymentDigest(pay *TypePayment.Payment) string {
+	body, err := proto.MarshalOptions{Deterministic: true}.Marshal(pay)
+	if err != nil {
+		return ""
+	}
+
+	sum := sha256.Sum256(body)
+	return hex.EncodeToString(sum[:])
+}
+
+// isNew 아직 전달하지 않은 변경인지 확인한다.
+func (c *Checkpoint) isNew(pay *TypePayment.Payment, digest string) bool {
+	for _, seen := range c.Seen {
+		if seen.ImpUID == pay.GetImpUid() {
+			return digest == "" || seen.Digest != digest
+		}
+	}
+
+	return true
+}
+
+// see 전달한 결제건을 기록한다. 같은 imp_uid 는 마지막 전달만 남긴다.
+func (c *Checkpoint) see(pay *TypePayment.Payment, digest string, cursor int64) {
+	for i := range c.Seen {
+		if c.Seen[i].ImpUID == pay.GetImpUid() {
+			c.Seen[i].Digest = digest
+			c.Seen[i].Cursor = cursor
+			return
+		}
+	}
+
+	c.Seen = append(c.Seen, SeenPayment{ImpUID: pay.GetImpUid(), Digest: digest, Cursor: cursor})
+}
+
+// advance 조회를 마친 구간의 끝으로 Cursor 를 옮기고, 다음 Poll 과 겹치지 않는 구간의 기록은 지운다.
+func (c *Checkpoint) advance(cursor, keepFrom int64) {
+	c.Cursor = cursor
+
+	seen := c.Seen[:0]
+	for _, s := range c.Seen {
+		if s.Cursor >= keepFrom {
+			seen = append(seen, s)
+		}
+	}
+	c.Seen = seen
+}
@@ -0,0 +1,280 @@
+package changefeed
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	TypePayment "github.com/iamport/interface/gen_src/go/v1/payment"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/iamport/go-iamport/contract"
+	"github.com/iamport/go-iamport/iamport"
+	"github.com/iamport/go-iamport/util"
+)
+
+var base = time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+
+type memoryStore struct {
+	checkpoint *Checkpoint
+	saves      int
+}
+
+func (s *memoryStore) Load(ctx context.Context) (*Checkpoint, error) {
+	if s.checkpoint == nil {
+		return nil, nil
+	}
+
+	copied := *s.checkpoint
+	copied.Seen = append([]SeenPayment{}, s.checkpoint.Seen...)
+	return &copied, nil
+}
+
+func (s *memoryStore) Save(ctx context.Context, checkpoint *Checkpoint) error {
+	s.saves++
+	copied := *checkpoint
+	copied.Seen = append([]SeenPayment{}, checkpoint.Seen...)
+	s.checkpoint = &copied
+	return nil
+}
+
+// feedServer from ~ to 사이에 최종수정된 결제건을 한 페이지로 응답한다.
+// updatedAt 에 있는 결제건은 결제 정보 대신 해당 시각을 아임포트의 최종수정시각으로 사용한다.
+type feedServer struct {
+	mu        sync.Mutex
+	payments  []*TypePayment.Payment
+	updatedAt map[string]int64
+}
+
+func (s *feedServer) handle(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	from, _ := strconv.ParseInt(r.URL.Query().Get("from"), 10, 64)
+	to, _ := strconv.ParseInt(r.URL.Query().Get("to"), 10, 64)
+
+	page := &TypePayment.PaymentPage{}
+	for _, pay := range s.payments {
+		updated, ok := s.updatedAt[pay.GetImpUid()]
+		if !ok {
+			updated = int64(iamport.UpdatedAt(pay))
+		}
+		if updated >= from && updated <= to {
+			page.List = append(page.List, pay)
+		}
+	}
+	page.Total = int32(len(page.List))
+
+	contract.Respond(w, page)
+}
+
+func at(d time.Duration) int32 {
+	return int32(base.Add(d).Unix())
+}
+
+func newPoller(t *testing.T, feed *feedServer, store *memoryStore, now time.Time) (*Poller, *contract.Server) {
+	server := contract.NewServer(feed.handle)
+	t.Cleanup(server.Close)
+
+	auth, err := server.Authenticate()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	poller := NewPoller(iamport.NewIamportWithAuthenticate(auth), store)
+	poller.Start = base
+	poller.now = func() time.Time { return now }
+
+	return poller, server
+}
+
+func collect(events *[]*Event) Handler {
+	return func(ctx context.Context, event *Event) error {
+		*events = append(*events, event)
+		return nil
+	}
+}
+
+func TestPollEmitsChangesInOrder(t *testing.T) {
+	feed := &feedServer{payments: []*TypePayment.Payment{
+		{ImpUid: "imp_paid", Status: string(util.StatusPaid), StartedAt: at(time.Minute), PaidAt: at(3 * time.Minute)},
+		{ImpUid: "imp_ready", Status: string(util.StatusReady), StartedAt: at(2 * time.Minute)},
+		{ImpUid: "imp_failed", Status: string(util.StatusFailed), StartedAt: at(time.Minute), FailedAt: at(4 * time.Minute)},
+		{ImpUid: "imp_partial", Status: string(util.StatusPaid), StartedAt: at(time.Minute), PaidAt: at(2 * time.Minute), CancelAmount: 100, Amount: 1000,
+			CancelHistory: []*TypePayment.CancelHistory{{Amount: 100, CancelledAt: at(5 * time.Minute)}}},
+		{ImpUid: "imp_cancelled", Status: string(util.StatusCancelled), StartedAt: at(time.Minute), CancelledAt: at(5 * time.Minute)},
+	}}
+	store := &memoryStore{}
+	poller, server := newPoller(t, feed, store, base.Add(time.Hour))
+
+	var events []*Event
+	n, err := poller.Poll(context.Background(), collect(&events))
+	assert.NoError(t, err)
+	assert.Equal(t, 5, n)
+
+	var types []EventType
+	for _, event := range events {
+		types = append(types, event.Type)
+	}
+	assert.Equal(t, []EventType{EventNew, EventPaid, EventFailed, EventPartiallyCancelled, EventCancelled}, types)
+	assert.True(t, base.Add(5*time.Minute).Equal(events[4].UpdatedAt))
+
+	assert.Equal(t, base.Add(time.Hour).Unix(), store.checkpoint.Cursor)
+	assert.Len(t, store.checkpoint.Seen, 5)
+	assert.Contains(t, server.LastRequest().RawQuery, "sorting=updated")
+	assert.Equal(t, "/payments/status/all", server.LastRequest().Path)
+
+	// 같은 시각에 처리한 결제건은 다시 전달하지 않는다.
+	events = nil
+	n, err = poller.Poll(context.Background(), collect(&events))
+	assert.NoError(t, err)
+	assert.Equal(t, 0, n)
+}
+
+func TestPollEmitsLaterChangeOfSamePayment(t *testing.T) {
+	pay := &TypePayment.Payment{ImpUid: "imp_1", Status: string(util.StatusReady), StartedAt: at(time.Minute)}
+	feed := &feedServer{payments: []*TypePayment.Payment{pay}}
+	store := &memoryStore{}
+	poller, _ := newPoller(t, feed, store, base.Add(time.Hour))
+
+	var events []*Event
+	_, err := poller.Poll(context.Background(), collect(&events))
+	assert.NoError(t, err)
+
+	feed.mu.Lock()
+	feed.payments = []*TypePayment.Payment{
+		{ImpUid: "imp_1", Status: string(util.StatusPaid), StartedAt: at(time.Minute), PaidAt: at(70 * time.Minute)},
+	}
+	feed.mu.Unlock()
+
+	poller.now = func() time.Time { return base.Add(2 * time.Hour) }
+
+	_, err = poller.Poll(context.Background(), collect(&events))
+	assert.NoError(t, err)
+	assert.Len(t, events, 2)
+	assert.Equal(t, EventNew, events[0].Type)
+	assert.Equal(t, EventPaid, events[1].Type)
+}
+
+func TestPollEmitsChangeWithoutTimestamp(t *testing.T) {
+	pay := &TypePayment.Payment{ImpUid: "imp_1", Status: string(util.StatusReady), StartedAt: at(time.Minute),
+		PayMethod: util.PayMethodVbank, VbankNum: "56211105948400"}
+	feed := &feedServer{payments: []*TypePayment.Payment{pay}}
+	store := &memoryStore{}
+	poller, server := newPoller(t, feed, store, base.Add(time.Hour))
+
+	var events []*Event
+	_, err := poller.Poll(context.Background(), collect(&events))
+	assert.NoError(t, err)
+	assert.Len(t, events, 1)
+
+	// 가상계좌 번호만 바뀌어 결제 정보의 시각은 그대로이지만 아임포트의 최종수정시각은 바뀌었다.
+	feed.mu.Lock()
+	feed.payments = []*TypePayment.Payment{
+		{ImpUid: "imp_1", Status: string(util.StatusReady), StartedAt: at(time.Minute),
+			PayMethod: util.PayMethodVbank, VbankNum: "56211105948401"},
+	}
+	feed.updatedAt = map[string]int64{"imp_1": base.Add(90 * time.Minute).Unix()}
+	feed.mu.Unlock()
+
+	poller.now = func() time.Time { return base.Add(2 * time.Hour) }
+	_, err = poller.Poll(context.Background(), collect(&events))
+	assert.NoError(t, err)
+	assert.Len(t, events, 2)
+	assert.Equal(t, "56211105948401", events[1].Payment.GetVbankNum())
+	assert.Contains(t, server.LastRequest().RawQuery, "from="+strconv.FormatInt(base.Add(time.Hour-DefaultOverlap).Unix(), 10))
+
+	// 겹쳐 조회한 구간에서 같은 결제 정보는 다시 전달하지 않는다.
+	poller.now = func() time.Time { return base.Add(2*time.Hour + 30*time.Second) }
+	feed.mu.Lock()
+	feed.updatedAt["imp_1"] = base.Add(2*time.Hour - 30*time.Second).Unix()
+	feed.mu.Unlock()
+
+	_, err = poller.Poll(context.Background(), collect(&events))
+	assert.NoError(t, err)
+	assert.Len(t, events, 2)
+	assert.Equal(t, base.Add(2*time.Hour+30*time.Second).Unix(), store.checkpoint.Cursor)
+}
+
+func TestPollHandlerErrorRedelivers(t *testing.T) {
+	feed := &feedServer{payments: []*TypePayment.Payment{
+		{ImpUid: "imp_1", Status: string(util.StatusPaid), StartedAt: at(time.Minute)},
+		{ImpUid: "imp_2", Status: string(util.StatusPaid), StartedAt: at(2 * time.Minute)},
+	}}
+	store := &memoryStore{}
+	poller, _ := newPoller(t, feed, store, base.Add(time.Hour))
+
+	fail := errors.New("db down")
+	n, err := poller.Poll(context.Background(), func(ctx context.Context, event *Event) error {
+		if event.Payment.GetImpUid() == "imp_2" {
+			return fail
+		}
+		return nil
+	})
+	assert.Equal(t, fail, err)
+	assert.Equal(t, 1, n)
+	assert.Equal(t, 1, store.saves)
+
+	var events []*Event
+	_, err = poller.Poll(context.Background(), collect(&events))
+	assert.NoError(t, err)
+	assert.Len(t, events, 1)
+	assert.Equal(t, "imp_2", events[0].Payment.GetImpUid())
+}
+
+func TestPollCatchesUpLongGap(t *testing.T) {
+	feed := &feedServer{payments: []*TypePayment.Payment{
+		{ImpUid: "imp_1", Status: string(util.StatusPaid), StartedAt: at(24 * time.Hour)},
+		{ImpUid: "imp_2", Status: string(util.StatusPaid), StartedAt: at(200 * 24 * time.Hour)},
+	}}
+	store := &memoryStore{}
+	poller, server := newPoller(t, feed, store, base.AddDate(1, 0, 0))
+
+	var events []*Event
+	n, err := poller.Poll(context.Background(), collect(&events))
+	assert.NoError(t, err)
+	assert.Equal(t, 2, n)
+	// 토큰 발급 1회 + 3개월 구간 4개
+	assert.Len(t, server.Requests(), 5)
+}
+
+func TestPollCatchesUpFromFebruary(t *testing.T) {
+	// 2월 1일 ~ 5월 1일은 89일이므로 90일 단위로 나누면 첫 구간부터 조회 기간 제한에 걸린다.
+	february := time.Date(2023, 2, 1, 0, 0, 0, 0, time.UTC)
+	feed := &feedServer{payments: []*TypePayment.Payment{
+		{ImpUid: "imp_1", Status: string(util.StatusPaid), StartedAt: int32(february.AddDate(0, 5, 0).Unix())},
+	}}
+	store := &memoryStore{checkpoint: &Checkpoint{Cursor: february.Add(DefaultOverlap).Unix()}}
+	poller, server := newPoller(t, feed, store, february.AddDate(0, 6, 0))
+
+	var events []*Event
+	n, err := poller.Poll(context.Background(), collect(&events))
+	assert.NoError(t, err)
+	assert.Equal(t, 1, n)
+	assert.Equal(t, february.AddDate(0, 6, 0).Unix(), store.checkpoint.Cursor)
+	// 토큰 발급 1회 + 3개월 구간 2개
+	assert.Len(t, server.Requests(), 3)
+}
+
+func TestPollDefaultStart(t *testing.T) {
+	store := &memoryStore{}
+	poller, server := newPoller(t, &feedServer{}, store, base.AddDate(1, 0, 0))
+	poller.Start = time.Time{}
+
+	_, err := poller.Poll(context.Background(), collect(&[]*Event{}))
+	assert.NoError(t, err)
+	assert.Len(t, server.Requests(), 2)
+	assert.Contains(t, server.LastRequest().RawQuery, "from="+strconv.FormatInt(iamport.ListPeriodStart(base.AddDate(1, 0, 0)).Unix(), 10))
+}
+
+func TestClassify(t *testing.T) {
+	assert.Equal(t, EventNew, Classify(&TypePayment.Payment{Status: string(util.StatusReady)}))
+	assert.Equal(t, EventPaid, Classify(&TypePayment.Payment{Status: string(util.StatusPaid), Amount: 1000}))
+	assert.Equal(t, EventPartiallyCancelled, Classify(&TypePayment.Payment{Status: string(util.StatusPaid), Amount: 1000, CancelAmount: 1}))
+	assert.Equal(t, EventCancelled, Classify(&TypePayment.Payment{Status: string(util.StatusCancelled)}))
+	assert.Equal(t, EventFailed, Classify(&TypePayment.Payment{Status: string(util.StatusFailed)}))
+}
@@ -0,0 +1,218 @@
+package checkout
+
+import (
+	"context"
+	"errors"
+
+	TypePayment "github.com/iamport/interface/gen_src/go/v1/payment"
+
+	"github.com/iamport/go-iamport/iamport"
+	"github.com/iamport/go-iamport/util"
+)
+
+const (
+	ErrMustExistOrder       = "iamport: order must be exist"
+	ErrMustExistImpUID      = "iamport: imp_uid must be exist"
+	ErrOrderAlreadyComplete = "iamport: order is already completed with another imp_uid"
+
+	// DefaultForgeryCancelReason 위변조 결제건을 자동 취소할 때의 취소 사유
+	DefaultForgeryCancelReason = "결제 금액 위변조 감지로 인한 자동 취소"
+)
+
+// OrderStatus 가맹점 주문 상태
+type OrderStatus string
+
+const (
+	OrderPending         OrderStatus = "pending"          // 결제 대기 (사전 등록됨)
+	OrderAwaitingDeposit OrderStatus = "awaiting_deposit" // 가상계좌 입금 대기
+	OrderPaid            OrderStatus = "paid"             // 결제완료, 주문 처리 가능
+	OrderForged          OrderStatus = "forged"           // 위변조 감지되어 결제 취소됨
+)
+
+// Order 가맹점 주문
+type Order struct {
+	MerchantUID string
+	Amount      int32
+	Currency    string // 비어있으면 KRW
+	Status      OrderStatus
+	ImpUID      string // 결제가 연결된 경우 imp_uid
+}
+
+// OrderStore 가맹점 주문 저장소
+type OrderStore interface {
+	// Load merchant_uid 로 주문을 가져온다. 주문이 없으면 nil, nil
+	Load(ctx context.Context, merchantUID string) (*Order, error)
+	// Save 주문을 저장한다.
+	Save(ctx context.Context, order *Order) error
+}
+
+// OutcomeKind 결제 완료 처리 결과
+type OutcomeKind string
+
+const (
+	OutcomePaid             OutcomeKind = "paid"              // 검증 완료, 주문 처리 가능
+	OutcomeAlreadyPaid      OutcomeKind = "already_paid"      // 이미 같은 imp_uid 로 완료 처리된 주문 (웹훅 중복 수신 등)
+	OutcomeAwaitingDeposit  OutcomeKind = "awaiting_deposit"  // 가상계좌 발급, 입금 대기
+	OutcomeNotPaid          OutcomeKind = "not_paid"          // 결제실패 또는 이미 취소된 결제건
+	OutcomeRejected         OutcomeKind = "rejected"          // 다른 주문의 결제건 등 주문 정보와 다르지만 자동 취소하지 않은 결제건
+	OutcomeForgeryCancelled OutcomeKind = "forgery_cancelled" // 금액 위변조 감지, 결제 취소 완료
+	OutcomeForgeryCancelErr OutcomeKind = "forgery_cancel_failed"
+)
+
+// Outcome 결제 완료 처리 결과
+type Outcome struct {
+	Kind    OutcomeKind
+	Order   *Order
+	Verdict *iamport.Verdict
+
+	// Cancelled 위변조로 자동 취소된 결제 정보 (OutcomeForgeryCancelled)
+	Cancelled *TypePayment.Payment
+	// CancelErr 자동 취소 실패 오류 (OutcomeForgeryCancelErr). 수동으로 환불해야 한다.
+	CancelErr error
+}
+
+// Checkout 결제 사전 등록 → 결제 → 검증 → 주문 처리(또는 자동 취소) 흐름
+type Checkout struct {
+	iamport *iamport.Iamport
+	store   OrderStore
+
+	// CancelReason 위변조 결제건 자동 취소 사유. 비어있으면 DefaultForgeryCancelReason
+	CancelReason string
+}
+
+// NewCheckout Checkout 을 만든다.
+func NewCheckout(iam *iamport.Iamport, store OrderStore) *Checkout {
+	return &Checkout{
+		iamport: iam,
+		store:   store,
+	}
+}
+
+// Prepare 결제 금액을 아임포트에 사전 등록하고 주문을 결제 대기 상태로 저장한다.
+// 사전 등록된 금액과 다르게 결제를 시도하면 아임포트가 결제를 거절한다.
+//
+// POST /payments/prepare
+func (c *Checkout) Prepare(ctx context.Context, order *Order) error {
+	if order == nil {
+		return errors.New(ErrMustExistOrder)
+	}
+
+	_, err := c.iamport.Payments.Prepare(order.MerchantUID, float64(order.Amount))
+	if err != nil {
+		return err
+	}
+
+	order.Status = OrderPending
+	order.ImpUID = ""
+
+	return c.store.Save(ctx, order)
+}
+
+// Complete 결제 후 전달받은 imp_uid 를 주문과 비교하여 주문 상태를 갱신한다.
+//
+// 결제완료(paid) 되었지만 금액이나 통화가 주문과 다르면 위변조로 판단하여 결제를 전액 취소한다.
+// merchant_uid 가 다른 결제건, 부분취소된 결제건, 금액이 다른 입금대기 가상계좌 등
+// 그 밖에 주문과 다른 결제건은 취소하지 않고 OutcomeRejected 를 return 해준다.
+// 주문을 가져오거나 저장하지 못한 경우, 결제 정보를 가져오지 못한 경우에만 error 를 return 해준다.
+func (c *Checkout) Complete(ctx context.Context, impUID, merchantUID string) (*Outcome, error) {
+	if impUID == "" {
+		return nil, errors.New(ErrMustExistImpUID)
+	}
+
+	order, err := c.store.Load(ctx, merchantUID)
+	if err != nil {
+		return nil, err
+	}
+
+	if order == nil {
+		return nil, errors.New(ErrMustExistOrder)
+	}
+
+	if order.Status == OrderPaid {
+		if order.ImpUID != impUID {
+			return nil, errors.New(ErrOrderAlreadyComplete)
+		}
+
+		return &Outcome{Kind: OutcomeAlreadyPaid, Order: order}, nil
+	}
+
+	verdict, err := c.iamport.Payments.Verify(impUID, iamport.Expectation{
+		MerchantUID: order.MerchantUID,
+		Amount:      order.Amount,
+		Currency:    order.Currency,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	outcome := &Outcome{Order: order, Verdict: verdict}
+
+	switch {
+	case verdict.OK():
+		outcome.Kind = OutcomePaid
+		return outcome, c.save(ctx, order, OrderPaid, impUID)
+
+	case verdict.AwaitingDeposit():
+		outcome.Kind = OutcomeAwaitingDeposit
+		return outcome, c.save(ctx, order, OrderAwaitingDeposit, impUID)
+
+	case verdict.Mismatch(iamport.VerifyFieldMerchantUID) != nil:
+		outcome.Kind = OutcomeRejected
+		return outcome, nil
+
+	case !util.PaymentStatus(verdict.Payment.GetStatus()).IsPaid() && !isDepositPending(verdict.Payment):
+		outcome.Kind = OutcomeNotPaid
+		return outcome, nil
+
+	case isForgery(verdict):
+		return c.cancelForgery(ctx, outcome, impUID)
+	}
+
+	// 부분취소된 결제건, 금액이 다른 입금대기 가상계좌 등은 자동 취소하지 않는다.
+	outcome.Kind = OutcomeRejected
+	return outcome, nil
+}
+
+// cancelForgery 주문과 금액이 다른 결제건을 남은 금액 전체 취소한다.
+func (c *Checkout) cancelForgery(ctx context.Context, outcome *Outcome, impUID string) (*Outcome, error) {
+	reason := c.CancelReason
+	if reason == "" {
+		reason = DefaultForgeryCancelReason
+	}
+
+	cancellable := float64(iamport.Cancellable(outcome.Verdict.Payment))
+
+	cancelled, err := c.iamport.Payments.Cancel(impUID, "", 0, 0, cancellable, reason, "", "", "")
+	if err != nil {
+		outcome.Kind = OutcomeForgeryCancelErr
+		outcome.CancelErr = err
+		return outcome, nil
+	}
+
+	outcome.Kind = OutcomeForgeryCancelled
+	outcome.Cancelled = cancelled
+
+	return outcome, c.save(ctx, outcome.Order, OrderForged, impUID)
+}
+
+func (c *Checkout) save(ctx context.Context, order *Order, status OrderStatus, impUID string) error {
+	order.Status = status
+	order.ImpUID = impUID
+
+	return c.store.Save(ctx, order)
+}
+
+// isForgery 결제완료된 결제건의 금액이나 통화가 주문과 다른지 확인한다.
+func isForgery(verdict *iamport.Verdict) bool {
+	if !util.PaymentStatus(verdict.Payment.GetStatus()).IsPaid() {
+		return false
+	}
+
+	return verdict.Mismatch(iamport.VerifyFieldAmount) != nil || verdict.Mismatch(iamport.VerifyFieldCurrency) != nil
+}
+
+// isDepositPending 가상계좌가 발급되어 입금을 기다리는 결제건인지 확인한다.
+func isDepositPending(pay *TypePayment.Payment) bool {
+	return util.PaymentStatus(pay.GetStatus()).IsReady() &&
+		pay.GetPayMethod() == util.PayMethodVbank && pay.GetVbankNum() != ""
+}
@@ -0,0 +1,220 @@
+package checkout
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	TypePayment "github.com/iamport/interface/gen_src/go/v1/payment"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/iamport/go-iamport/contract"
+	"github.com/iamport/go-iamport/iamport"
+	"github.com/iamport/go-iamport/util"
+)
+
+type memoryStore struct {
+	mu     sync.Mutex
+	orders map[string]*Order
+}
+
+func (s *memoryStore) Load(ctx context.Context, merchantUID string) (*Order, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	order, ok := s.orders[merchantUID]
+	if !ok {
+		return nil, nil
+	}
+
+	copied := *order
+	return &copied, nil
+}
+
+func (s *memoryStore) Save(ctx context.Context, order *Order) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	copied := *order
+	s.orders[order.MerchantUID] = &copied
+	return nil
+}
+
+// newCheckout pay 를 GET /payments/{imp_uid} 응답으로 사용하는 Checkout
+// cancelErr 가 있으면 결제 취소 요청에 해당 메시지로 실패 응답한다.
+func newCheckout(t *testing.T, pay *TypePayment.Payment, cancelErr string) (*Checkout, *memoryStore, *contract.Server) {
+	server := contract.NewServer(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/payments/prepare":
+			contract.Respond(w, &TypePayment.Prepare{MerchantUid: r.FormValue("merchant_uid")})
+		case r.URL.Path == "/payments/cancel":
+			if cancelErr != "" {
+				contract.RespondError(w, cancelErr)
+				return
+			}
+
+			cancelled := proto.Clone(pay).(*TypePayment.Payment)
+			cancelled.Status = string(util.StatusCancelled)
+			cancelled.CancelAmount = cancelled.Amount
+			contract.Respond(w, cancelled)
+		case strings.HasPrefix(r.URL.Path, "/payments/"):
+			contract.Respond(w, pay)
+		}
+	})
+	t.Cleanup(server.Close)
+
+	auth, err := server.Authenticate()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	store := &memoryStore{orders: map[string]*Order{}}
+	return NewCheckout(iamport.NewIamportWithAuthenticate(auth), store), store, server
+}
+
+func payment(status util.PaymentStatus, amount int32) *TypePayment.Payment {
+	return &TypePayment.Payment{
+		ImpUid:      "imp_1",
+		MerchantUid: "order_1",
+		Amount:      amount,
+		Status:      string(status),
+		PayMethod:   util.PayMethodCard,
+	}
+}
+
+func prepare(t *testing.T, c *Checkout) {
+	err := c.Prepare(context.Background(), &Order{MerchantUID: "order_1", Amount: 1000})
+	assert.NoError(t, err)
+}
+
+func TestPrepare(t *testing.T) {
+	c, store, server := newCheckout(t, nil, "")
+	prepare(t, c)
+
+	assert.Equal(t, "/payments/prepare", server.LastRequest().Path)
+	assert.Equal(t, "amount=1000&merchant_uid=order_1", string(server.LastRequest().Body))
+	assert.Equal(t, OrderPending, store.orders["order_1"].Status)
+}
+
+func TestCompletePaid(t *testing.T) {
+	c, store, _ := newCheckout(t, payment(util.StatusPaid, 1000), "")
+	prepare(t, c)
+
+	outcome, err := c.Complete(context.Background(), "imp_1", "order_1")
+	assert.NoError(t, err)
+	assert.Equal(t, OutcomePaid, outcome.Kind)
+	assert.Equal(t, OrderPaid, store.orders["order_1"].Status)
+	assert.Equal(t, "imp_1", store.orders["order_1"].ImpUID)
+
+	// 웹훅과 리다이렉트로 두 번 호출되어도 다시 처리하지 않는다.
+	outcome, err = c.Complete(context.Background(), "imp_1", "order_1")
+	assert.NoError(t, err)
+	assert.Equal(t, OutcomeAlreadyPaid, outcome.Kind)
+
+	_, err = c.Complete(context.Background(), "imp_2", "order_1")
+	assert.EqualError(t, err, ErrOrderAlreadyComplete)
+}
+
+func TestCompleteForgeryCancelled(t *testing.T) {
+	c, store, server := newCheckout(t, payment(util.StatusPaid, 10), "")
+	prepare(t, c)
+
+	outcome, err := c.Complete(context.Background(), "imp_1", "order_1")
+	assert.NoError(t, err)
+	assert.Equal(t, OutcomeForgeryCancelled, outcome.Kind)
+	assert.Equal(t, "10", outcome.Verdict.Mismatch(iamport.VerifyFieldAmount).Actual)
+	assert.Equal(t, string(util.StatusCancelled), outcome.Cancelled.GetStatus())
+	assert.Equal(t, OrderForged, store.orders["order_1"].Status)
+
+	cancel := server.LastRequest()
+	assert.Equal(t, "/payments/cancel", cancel.Path)
+	assert.Contains(t, string(cancel.Body), "checksum=10")
+	assert.Contains(t, string(cancel.Body), "imp_uid=imp_1")
+}
+
+func TestCompleteForgeryCancelFailed(t *testing.T) {
+	c, store, _ := newCheckout(t, payment(util.StatusPaid, 10), "취소 실패")
+	prepare(t, c)
+
+	outcome, err := c.Complete(context.Background(), "imp_1", "order_1")
+	assert.NoError(t, err)
+	assert.Equal(t, OutcomeForgeryCancelErr, outcome.Kind)
+	assert.EqualError(t, outcome.CancelErr, "취소 실패")
+	// 취소되지 않았으므로 주문은 결제 대기로 남겨 수동 환불 대상이 된다.
+	assert.Equal(t, OrderPending, store.orders["order_1"].Status)
+}
+
+func TestCompleteOtherOrderNotCancelled(t *testing.T) {
+	pay := payment(util.StatusPaid, 10)
+	pay.MerchantUid = "order_2"
+	c, store, server := newCheckout(t, pay, "")
+	prepare(t, c)
+
+	outcome, err := c.Complete(context.Background(), "imp_1", "order_1")
+	assert.NoError(t, err)
+	assert.Equal(t, OutcomeRejected, outcome.Kind)
+	assert.Equal(t, OrderPending, store.orders["order_1"].Status)
+	assert.NotEqual(t, "/payments/cancel", server.LastRequest().Path)
+}
+
+func TestCompletePartiallyCancelledNotCancelled(t *testing.T) {
+	pay := payment(util.StatusPaid, 1000)
+	pay.CancelAmount = 300
+	c, store, server := newCheckout(t, pay, "")
+	prepare(t, c)
+
+	outcome, err := c.Complete(context.Background(), "imp_1", "order_1")
+	assert.NoError(t, err)
+	assert.Equal(t, OutcomeRejected, outcome.Kind)
+	assert.Equal(t, "300", outcome.Verdict.Mismatch(iamport.VerifyFieldCancelAmount).Actual)
+	assert.Equal(t, OrderPending, store.orders["order_1"].Status)
+	assert.NotEqual(t, "/payments/cancel", server.LastRequest().Path)
+}
+
+func TestCompleteAwaitingDepositWrongAmountNotCancelled(t *testing.T) {
+	pay := payment(util.StatusReady, 10)
+	pay.PayMethod = util.PayMethodVbank
+	pay.VbankNum = "56211105948400"
+	c, store, server := newCheckout(t, pay, "")
+	prepare(t, c)
+
+	outcome, err := c.Complete(context.Background(), "imp_1", "order_1")
+	assert.NoError(t, err)
+	assert.Equal(t, OutcomeRejected, outcome.Kind)
+	assert.Equal(t, "10", outcome.Verdict.Mismatch(iamport.VerifyFieldAmount).Actual)
+	assert.Equal(t, OrderPending, store.orders["order_1"].Status)
+	assert.NotEqual(t, "/payments/cancel", server.LastRequest().Path)
+}
+
+func TestCompleteNotPaid(t *testing.T) {
+	c, _, server := newCheckout(t, payment(util.StatusFailed, 1000), "")
+	prepare(t, c)
+
+	outcome, err := c.Complete(context.Background(), "imp_1", "order_1")
+	assert.NoError(t, err)
+	assert.Equal(t, OutcomeNotPaid, outcome.Kind)
+	assert.Equal(t, "/payments/imp_1", server.LastRequest().Path)
+}
+
+func TestCompleteAwaitingDeposit(t *testing.T) {
+	pay := payment(util.StatusReady, 1000)
+	pay.PayMethod = util.PayMethodVbank
+	pay.VbankNum = "56211105948400"
+	c, store, _ := newCheckout(t, pay, "")
+	prepare(t, c)
+
+	outcome, err := c.Complete(context.Background(), "imp_1", "order_1")
+	assert.NoError(t, err)
+	assert.Equal(t, OutcomeAwaitingDeposit, outcome.Kind)
+	assert.Equal(t, OrderAwaitingDeposit, store.orders["order_1"].Status)
+}
+
+func TestCompleteWithoutOrder(t *testing.T) {
+	c, _, _ := newCheckout(t, nil, "")
+
+	_, err := c.Complete(context.Background(), "imp_1", "order_1")
+	assert.EqualError(t, err, ErrMustExistOrder)
+}
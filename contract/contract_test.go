@@ -0,0 +1,488 @@
+package contract
+
+import (
+	"bytes"
+	"flag"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	TypeEscrow "github.com/iamport/interface/gen_src/go/v1/escrow"
+	TypePayment "github.com/iamport/interface/gen_src/go/v1/payment"
+	TypeSubscribe "github.com/iamport/interface/gen_src/go/v1/subscribe"
+	TypeSubscribeCust "github.com/iamport/interface/gen_src/go/v1/subscribe_customers"
+
+	"github.com/iamport/go-iamport/authenticate"
+	"github.com/iamport/go-iamport/certification"
+	"github.com/iamport/go-iamport/escrow"
+	"github.com/iamport/go-iamport/payment"
+	"github.com/iamport/go-iamport/receipt"
+	"github.com/iamport/go-iamport/subscribe"
+	subscribeCust "github.com/iamport/go-iamport/subscribe_customer"
+	"github.com/iamport/go-iamport/util"
+	"github.com/iamport/go-iamport/vbank"
+)
+
+// go test ./contract -update 로 golden 파일을 갱신한다.
+var update = flag.Bool("update", false, "update golden files")
+
+type endpointCase struct {
+	name string
+	call func(auth *authenticate.Authenticate, token string) error
+}
+
+var endpointCases = []endpointCase{
+	// authenticate
+	{"authenticate_get_token", func(auth *authenticate.Authenticate, token string) error {
+		return auth.RequestToken()
+	}},
+
+	// payments
+	{"payment_get_by_imp_uid", func(auth *authenticate.Authenticate, token string) error {
+		_, err := payment.GetByImpUID(auth.Client, auth.APIUrl, token, &TypePayment.PaymentRequest{
+			ImpUid: "imp_785510843101",
+		})
+		return err
+	}},
+	{"payment_get_by_imp_uids", func(auth *authenticate.Authenticate, token string) error {
+		_, err := payment.GetByImpUIDs(auth.Client, auth.APIUrl, token, &TypePayment.PaymentsRequest{
+			ImpUid: []string{"imp_785510843101", "imp_338103167934"},
+		})
+		return err
+	}},
+	{"payment_get_by_merchant_uid", func(auth *authenticate.Authenticate, token string) error {
+		_, err := payment.GetByMerchantUID(auth.Client, auth.APIUrl, token, &TypePayment.PaymentMerchantUidRequest{
+			MerchantUid: "ORD20180131-0009728",
+			Status:      "paid",
+			Sorting:     "-started",
+		})
+		return err
+	}},
+	{"payment_get_by_merchant_uids", func(auth *authenticate.Authenticate, token string) error {
+		_, err := payment.GetByMerchantUIDs(auth.Client, auth.APIUrl, token, &TypePayment.PaymentsMerchantUidRequest{
+			MerchantUid: "ORD20180131-0009728",
+			Status:      "paid",
+			Sorting:     "paid",
+			Page:        2,
+		})
+		return err
+	}},
+	{"payment_get_by_status", func(auth *authenticate.Authenticate, token string) error {
+		_, err := payment.GetByStatus(auth.Client, auth.APIUrl, token, &TypePayment.PaymentStatusRequest{
+			Status:  "paid",
+			Page:    3,
+			Limit:   20,
+			From:    1598918400,
+			To:      1599004800,
+			Sorting: "-updated",
+		})
+		return err
+	}},
+	{"payment_get_balance_by_imp_uid", func(auth *authenticate.Authenticate, token string) error {
+		_, err := payment.GetBalanceByImpUID(auth.Client, auth.APIUrl, token, &TypePayment.PaymentBalanceRequest{
+			ImpUid: "imp_088621754304",
+		})
+		return err
+	}},
+	{"payment_cancel", func(auth *authenticate.Authenticate, token string) error {
+		_, err := payment.Cancel(auth.Client, auth.APIUrl, token, &TypePayment.PaymentCancelRequest{
+			ImpUid:        "imp_338103167934",
+			MerchantUid:   "merchant_1601964102920",
+			Amount:        1000,
+			TaxFree:       100,
+			Checksum:      5000,
+			Reason:        "고객 요청: 단순 변심, 재구매 예정",
+			RefundHolder:  "홍길동",
+			RefundBank:    "04",
+			RefundAccount: "79959078731512",
+		})
+		return err
+	}},
+	{"payment_prepare", func(auth *authenticate.Authenticate, token string) error {
+		_, err := payment.Prepare(auth.Client, auth.APIUrl, token, &TypePayment.PaymentPrepareRequest{
+			MerchantUid: "ORD20180131-0009728",
+			Amount:      1004,
+		})
+		return err
+	}},
+	{"payment_get_prepare_by_merchant_uid", func(auth *authenticate.Authenticate, token string) error {
+		_, err := payment.GetPrepareByMerchantUID(auth.Client, auth.APIUrl, token, &TypePayment.PaymentGetPrepareRequest{
+			MerchantUid: "ORD20180131-0009728",
+		})
+		return err
+	}},
+
+	// escrows
+	{"escrow_register_logis", func(auth *authenticate.Authenticate, token string) error {
+		_, err := escrow.RegisterLogis(auth.Client, auth.APIUrl, token, escrowLogisRequest)
+		return err
+	}},
+	{"escrow_update_logis", func(auth *authenticate.Authenticate, token string) error {
+		_, err := escrow.UpdateLogis(auth.Client, auth.APIUrl, token, escrowLogisRequest)
+		return err
+	}},
+
+	// vbanks
+	{"vbank_issue", func(auth *authenticate.Authenticate, token string) error {
+		_, err := vbank.Issue(auth.Client, auth.APIUrl, token, &vbank.IssueRequest{
+			MerchantUID: "ORD20180131-0009728",
+			Amount:      50000,
+			VbankCode:   string(vbank.BankShinhan),
+			VbankDue:    1893455999,
+			VbankHolder: "아임포트",
+			Name:        "B2B 정산",
+			BuyerName:   "홍길동",
+			BuyerEmail:  "example@example.com",
+			NoticeURL:   "https://example.com/iamport/webhook",
+		})
+		return err
+	}},
+	{"vbank_edit", func(auth *authenticate.Authenticate, token string) error {
+		_, err := vbank.Edit(auth.Client, auth.APIUrl, token, &vbank.EditRequest{
+			ImpUID:   "imp_448280090638",
+			Amount:   45000,
+			VbankDue: 1893542399,
+		})
+		return err
+	}},
+	{"vbank_delete", func(auth *authenticate.Authenticate, token string) error {
+		_, err := vbank.Delete(auth.Client, auth.APIUrl, token, &vbank.DeleteRequest{
+			ImpUID: "imp_448280090638",
+		})
+		return err
+	}},
+	{"vbank_get_holder", func(auth *authenticate.Authenticate, token string) error {
+		_, err := vbank.GetHolder(auth.Client, auth.APIUrl, token, &vbank.HolderRequest{
+			BankCode: string(vbank.BankKB),
+			BankNum:  "79959078731512",
+		})
+		return err
+	}},
+
+	// certifications
+	{"certification_get_by_imp_uid", func(auth *authenticate.Authenticate, token string) error {
+		_, err := certification.GetByImpUID(auth.Client, auth.APIUrl, token, &certification.CertificationRequest{
+			ImpUID: "imp_448280090638",
+		})
+		return err
+	}},
+	{"certification_delete", func(auth *authenticate.Authenticate, token string) error {
+		_, err := certification.Delete(auth.Client, auth.APIUrl, token, &certification.CertificationRequest{
+			ImpUID: "imp_448280090638",
+		})
+		return err
+	}},
+	{"certification_otp_request", func(auth *authenticate.Authenticate, token string) error {
+		_, err := certification.RequestOTP(auth.Client, auth.APIUrl, token, &certification.OTPRequest{
+			Name:        "홍길동",
+			Phone:       "01012341234",
+			Birth:       "19900101",
+			GenderDigit: "1",
+			Carrier:     "KTF",
+			IsMVNO:      true,
+			Company:     "아임포트",
+			MerchantUID: "signup_1",
+		})
+		return err
+	}},
+	{"certification_otp_confirm", func(auth *authenticate.Authenticate, token string) error {
+		_, err := certification.ConfirmOTP(auth.Client, auth.APIUrl, token, &certification.OTPConfirmRequest{
+			ImpUID: "imp_448280090638",
+			OTP:    "123456",
+		})
+		return err
+	}},
+
+	// receipts
+	{"receipt_issue", func(auth *authenticate.Authenticate, token string) error {
+		_, err := receipt.Issue(auth.Client, auth.APIUrl, token, &receipt.IssueRequest{
+			ImpUID:         "imp_448280090638",
+			Identifier:     "01012341234",
+			IdentifierType: string(receipt.IdentifierPhone),
+			Type:           string(receipt.PurposePersonal),
+			BuyerName:      "홍길동",
+			BuyerEmail:     "example@example.com",
+			BuyerTel:       "010-1234-1234",
+			TaxFree:        100,
+		})
+		return err
+	}},
+	{"receipt_get_by_imp_uid", func(auth *authenticate.Authenticate, token string) error {
+		_, err := receipt.GetByImpUID(auth.Client, auth.APIUrl, token, &receipt.ReceiptRequest{
+			ImpUID: "imp_448280090638",
+		})
+		return err
+	}},
+	{"receipt_cancel", func(auth *authenticate.Authenticate, token string) error {
+		_, err := receipt.Cancel(auth.Client, auth.APIUrl, token, &receipt.ReceiptRequest{
+			ImpUID: "imp_448280090638",
+		})
+		return err
+	}},
+
+	// subscribe
+	{"subscribe_onetime", func(auth *authenticate.Authenticate, token string) error {
+		_, err := subscribe.Onetime(auth.Client, auth.APIUrl, token, &TypeSubscribe.OnetimePaymentRequest{
+			MerchantUid:            "merchant_onetime",
+			Amount:                 1000,
+			TaxFree:                100,
+			CardNumber:             "1111-2222-3333-4444",
+			Expiry:                 "2025-08",
+			Birth:                  "911125",
+			Pwd_2Digit:             "11",
+			CustomerUid:            "customer_1234",
+			Pg:                     "nice.iamport00m",
+			Name:                   "아임포트 GO SDK 테스트",
+			BuyerName:              "홍길동",
+			BuyerEmail:             "example@example.com",
+			BuyerTel:               "010-1234-1234",
+			BuyerAddr:              "서울특별시 강남구 신사동",
+			BuyerPostcode:          "01181",
+			CardQuota:              3,
+			InterestFreeByMerchant: true,
+			CustomData:             `{"order":"A-100"}`,
+			NoticeUrl:              "https://example.com/iamport/webhook?source=onetime",
+		})
+		return err
+	}},
+	{"subscribe_onetime_with_extra", func(auth *authenticate.Authenticate, token string) error {
+		_, err := subscribe.Onetime(auth.Client, auth.APIUrl, token, &TypeSubscribe.OnetimePaymentRequest{
+			MerchantUid: "merchant_onetime",
+			Amount:      1000,
+			TaxFree:     1000,
+			CardNumber:  "1111-2222-3333-4444",
+			Expiry:      "2025-08",
+			Birth:       "911125",
+		}, util.Params{
+			"vat_amount": 0,
+			"extra":      map[string]string{"naverUseCfm": "20301231"},
+		})
+		return err
+	}},
+	{"subscribe_onetime_explicit_zero", func(auth *authenticate.Authenticate, token string) error {
+		_, err := subscribe.Onetime(auth.Client, auth.APIUrl, token, &TypeSubscribe.OnetimePaymentRequest{
+			MerchantUid: "merchant_onetime",
+			Amount:      1000,
+			CardNumber:  "1111-2222-3333-4444",
+			Expiry:      "2025-08",
+			Birth:       "911125",
+		}, util.Params{
+			"tax_free":                  0,
+			"card_quota":                0,
+			"interest_free_by_merchant": false,
+		})
+		return err
+	}},
+	{"subscribe_again", func(auth *authenticate.Authenticate, token string) error {
+		_, err := subscribe.Again(auth.Client, auth.APIUrl, token, &TypeSubscribe.AgainPaymentRequest{
+			CustomerUid:   "customer_1234",
+			MerchantUid:   "merchant_again",
+			Amount:        1000,
+			Name:          "아임포트 GO SDK 테스트",
+			BuyerName:     "홍길동",
+			BuyerEmail:    "example@example.com",
+			BuyerTel:      "010-1234-1234",
+			CardQuota:     2,
+			NoticeUrl:     "https://example.com/iamport/webhook",
+			BuyerPostcode: "01181",
+		})
+		return err
+	}},
+	{"subscribe_schedule", func(auth *authenticate.Authenticate, token string) error {
+		_, err := subscribe.Schedule(auth.Client, auth.APIUrl, token, &TypeSubscribe.SchedulePayemntRequest{
+			CustomerUid:    "customer_1234",
+			CheckingAmount: 1000,
+			CardNumber:     "1111-2222-3333-4444",
+			Expiry:         "2025-08",
+			Birth:          "911125",
+			Pwd_2Digit:     "11",
+			Pg:             "nice.iamport00m",
+			Schedules: []*TypeSubscribe.PaymentScheduleParam{
+				{
+					MerchantUid: "merchant_schedule_1",
+					ScheduleAt:  1893456000,
+					Amount:      1000,
+					Name:        "정기결제 1회차",
+					BuyerName:   "홍길동",
+					BuyerEmail:  "example@example.com",
+				},
+				{
+					MerchantUid: "merchant_schedule_2",
+					ScheduleAt:  1896134400,
+					Amount:      1000,
+					TaxFree:     100,
+					Name:        "정기결제 2회차",
+				},
+			},
+		})
+		return err
+	}},
+	{"subscribe_unschedule", func(auth *authenticate.Authenticate, token string) error {
+		_, err := subscribe.Unschedule(auth.Client, auth.APIUrl, token, &TypeSubscribe.UnschedulePaymentRequest{
+			CustomerUid: "customer_1234",
+			MerchantUid: []string{"merchant_schedule_1", "merchant_schedule_2"},
+		})
+		return err
+	}},
+	{"subscribe_get_schedule_by_merchant_uid", func(auth *authenticate.Authenticate, token string) error {
+		_, err := subscribe.GetScheduledPaymentByMerchantUID(auth.Client, auth.APIUrl, token, &TypeSubscribe.GetPaymentScheduleRequest{
+			MerchantUid: "merchant_schedule_1",
+		})
+		return err
+	}},
+	{"subscribe_get_schedule_by_customer_uid", func(auth *authenticate.Authenticate, token string) error {
+		_, err := subscribe.GetScheduledPaymentByCustomerUID(auth.Client, auth.APIUrl, token, &TypeSubscribe.GetPaymentScheduleByCustomerRequest{
+			CustomerUid:    "customer_1234",
+			Page:           1,
+			From:           1893456000,
+			To:             1896134400,
+			ScheduleStatus: "scheduled",
+		})
+		return err
+	}},
+
+	// subscribe.customer
+	{"subscribe_customer_get_multiple_billing_keys", func(auth *authenticate.Authenticate, token string) error {
+		_, err := subscribeCust.GetMultipleBillingKeysByCustomer(auth.Client, auth.APIUrl, token, &TypeSubscribeCust.GetMultipleCustomerBillingKeyRequest{
+			CustomerUid: []string{"customer_1234", "customer_5678"},
+		})
+		return err
+	}},
+	{"subscribe_customer_delete_billing_key", func(auth *authenticate.Authenticate, token string) error {
+		_, err := subscribeCust.DeleteBillingKey(auth.Client, auth.APIUrl, token, &TypeSubscribeCust.DeleteCustomerBillingKeyRequest{
+			CustomerUid: "customer_1234",
+			Reason:      "구독 해지",
+			Requester:   "customer",
+		})
+		return err
+	}},
+	{"subscribe_customer_get_billing_key", func(auth *authenticate.Authenticate, token string) error {
+		_, err := subscribeCust.GetBillingKeyByCustomer(auth.Client, auth.APIUrl, token, &TypeSubscribeCust.GetCustomerBillingKeyRequest{
+			CustomerUid: "customer_1234",
+		})
+		return err
+	}},
+	{"subscribe_customer_insert_billing_key", func(auth *authenticate.Authenticate, token string) error {
+		_, err := subscribeCust.InsertBillingKeyByCustomer(auth.Client, auth.APIUrl, token, &TypeSubscribeCust.InsertCustomerBillingKeyRequest{
+			CustomerUid:      "customer_1234",
+			Pg:               "nice.iamport00m",
+			CardNumber:       "1111-2222-3333-4444",
+			Expiry:           "2025-08",
+			Birth:            "911125",
+			Pwd_2Digit:       "11",
+			CustomerName:     "홍길동",
+			CustomerTel:      "010-1234-1234",
+			CustomerEmail:    "example@example.com",
+			CustomerAddr:     "서울특별시 강남구 신사동",
+			CustomerPostcode: "01181",
+		})
+		return err
+	}},
+	{"subscribe_customer_get_payments", func(auth *authenticate.Authenticate, token string) error {
+		_, err := subscribeCust.GetPaymentsByCustomer(auth.Client, auth.APIUrl, token, &TypeSubscribeCust.GetPaidByBillingKeyListRequest{
+			CustomerUid: "customer_1234",
+			Page:        2,
+		})
+		return err
+	}},
+	{"subscribe_customer_get_schedules", func(auth *authenticate.Authenticate, token string) error {
+		_, err := subscribeCust.GetScheduledPaymentByCustomerUID(auth.Client, auth.APIUrl, token, &TypeSubscribe.GetPaymentScheduleByCustomerRequest{
+			CustomerUid:    "customer_1234",
+			Page:           1,
+			From:           1893456000,
+			To:             1896134400,
+			ScheduleStatus: "scheduled",
+		})
+		return err
+	}},
+}
+
+var escrowLogisRequest = &TypeEscrow.EscrowRequest{
+	ImpUid: "imp_448280090638",
+	Sender: &TypeEscrow.Info{
+		Name:     "아임포트",
+		Tel:      "02-1670-5176",
+		Addr:     "서울특별시 강남구 삼성동",
+		Postcode: "06180",
+	},
+	Receiver: &TypeEscrow.Info{
+		Name:     "홍길동",
+		Tel:      "010-1234-1234",
+		Addr:     "서울특별시 강남구 신사동",
+		Postcode: "01181",
+	},
+	Logis: &TypeEscrow.Logis{
+		Company: string(escrow.CourierCJ),
+		Invoice: "123456789012",
+		SentAt:  1609459200,
+	},
+}
+
+func TestEndpointContracts(t *testing.T) {
+	server := NewServer(nil)
+	defer server.Close()
+
+	auth, err := server.Authenticate()
+	assert.NoError(t, err)
+
+	token, err := auth.GetToken()
+	assert.NoError(t, err)
+	assert.Equal(t, Token, token)
+
+	for _, c := range endpointCases {
+		t.Run(c.name, func(t *testing.T) {
+			server.Reset()
+
+			err := c.call(auth, token)
+			assert.NoError(t, err)
+
+			requests := server.Requests()
+			if !assert.Len(t, requests, 1) {
+				return
+			}
+
+			assertGolden(t, c.name, requests[0].Golden())
+		})
+	}
+}
+
+func TestGoldenJsonBodyIsIndented(t *testing.T) {
+	req := &Request{
+		Method: "POST",
+		Path:   "/subscribe/payments/unschedule",
+		Header: map[string][]string{
+			"Authorization": {Token},
+			"Content-Type":  {"application/json"},
+		},
+		Body: []byte(`{"customer_uid":"c1", "merchant_uid":["m1", "m2"]}`),
+	}
+
+	expected := "POST /subscribe/payments/unschedule\n" +
+		"Authorization: " + Token + "\n" +
+		"Content-Type: application/json\n" +
+		"\n" +
+		"{\n  \"customer_uid\": \"c1\",\n  \"merchant_uid\": [\n    \"m1\",\n    \"m2\"\n  ]\n}\n"
+	assert.Equal(t, expected, string(req.Golden()))
+}
+
+func assertGolden(t *testing.T, name string, got []byte) {
+	t.Helper()
+
+	path := filepath.Join("testdata", name+".golden")
+	if *update {
+		err := ioutil.WriteFile(path, got, 0644)
+		assert.NoError(t, err)
+		return
+	}
+
+	expected, err := ioutil.ReadFile(path)
+	if !assert.NoError(t, err, "golden 파일이 없습니다. go test ./contract -update 로 생성하세요") {
+		return
+	}
+
+	if !bytes.Equal(expected, got) {
+		t.Errorf("%s: request does not match golden file\n--- expected\n%s\n--- got\n%s", path, expected, got)
+	}
+}
@@ -0,0 +1,203 @@
+package contract
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/iamport/go-iamport/authenticate"
+	"github.com/iamport/go-iamport/util"
+)
+
+const (
+	APIKey    = "contract_api_key"
+	APISecret = "contract_api_secret"
+	Token     = "contract_access_token"
+
+	ResponseEmpty = `{"code":0,"message":null,"response":null}`
+)
+
+// GoldenHeaders golden 파일에 기록되는 헤더 목록
+var GoldenHeaders = []string{util.HeaderAuthorization, util.HeaderContentType}
+
+// Request 로컬 서버가 받은 요청
+type Request struct {
+	Method   string
+	Path     string
+	RawQuery string
+	Header   http.Header
+	Body     []byte
+}
+
+// Server 아임포트 REST API를 흉내내는 로컬 서버
+// 받은 요청을 모두 기록하며, /users/getToken 요청에는 고정된 토큰을 응답한다.
+type Server struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	requests []*Request
+	handler  http.HandlerFunc
+}
+
+// NewServer 로컬 서버를 띄운다. handler가 nil이면 모든 요청에 ResponseEmpty를 응답한다.
+func NewServer(handler http.HandlerFunc) *Server {
+	s := &Server{
+		handler: handler,
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+
+	return s
+}
+
+// Authenticate 로컬 서버로 요청을 보내는 authenticate 모듈을 return 해준다.
+func (s *Server) Authenticate() (*authenticate.Authenticate, error) {
+	return authenticate.NewAuthenticate(s.URL, s.Client(), APIKey, APISecret)
+}
+
+// Requests 지금까지 기록된 요청들
+func (s *Server) Requests() []*Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	requests := make([]*Request, len(s.requests))
+	copy(requests, s.requests)
+
+	return requests
+}
+
+// LastRequest 마지막으로 기록된 요청
+func (s *Server) LastRequest() *Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(s.requests) == 0 {
+		return nil
+	}
+
+	return s.requests[len(s.requests)-1]
+}
+
+// Reset 기록된 요청들을 비운다.
+func (s *Server) Reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.requests = nil
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	r.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	s.mu.Lock()
+	s.requests = append(s.requests, &Request{
+		Method:   r.Method,
+		Path:     r.URL.Path,
+		RawQuery: r.URL.RawQuery,
+		Header:   r.Header.Clone(),
+		Body:     body,
+	})
+	s.mu.Unlock()
+
+	if r.URL.Path == authenticate.URLGetToken {
+		fmt.Fprintf(w, `{"code":0,"message":null,"response":{"access_token":"%s","now":%d,"expired_at":%d}}`,
+			Token, time.Now().Unix(), time.Now().Add(30*time.Minute).Unix())
+		return
+	}
+
+	if s.handler != nil {
+		s.handler(w, r)
+		return
+	}
+
+	fmt.Fprint(w, ResponseEmpty)
+}
+
+// Respond 성공(code 0) 응답 본문에 response를 담아 응답한다.
+// response는 encoding/json으로 직렬화되므로 proto 메시지를 그대로 넘겨도 된다.
+func Respond(w http.ResponseWriter, response interface{}) {
+	body, err := json.Marshal(response)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	fmt.Fprintf(w, `{"code":0,"message":null,"response":%s}`, body)
+}
+
+// RespondError 아임포트 오류(code -1) 응답
+func RespondError(w http.ResponseWriter, message string) {
+	body, _ := json.Marshal(message)
+	fmt.Fprintf(w, `{"code":-1,"message":%s,"response":null}`, body)
+}
+
+// Golden 요청을 golden 파일 형식으로 변환한다.
+//
+//	METHOD /path?query
+//	Header: value
+//
+//	body
+//
+// json body는 들여쓰기 형태로, form body는 인코딩된 그대로 한 줄에 하나의 파라미터씩 기록한다.
+func (r *Request) Golden() []byte {
+	var buf bytes.Buffer
+
+	buf.WriteString(r.Method)
+	buf.WriteString(" ")
+	buf.WriteString(r.Path)
+	if r.RawQuery != "" {
+		buf.WriteString("?")
+		buf.WriteString(r.RawQuery)
+	}
+	buf.WriteString("\n")
+
+	headers := append([]string{}, GoldenHeaders...)
+	sort.Strings(headers)
+	for _, key := range headers {
+		if value := r.Header.Get(key); value != "" {
+			buf.WriteString(key)
+			buf.WriteString(": ")
+			buf.WriteString(value)
+			buf.WriteString("\n")
+		}
+	}
+
+	if len(r.Body) > 0 {
+		buf.WriteString("\n")
+		buf.Write(r.formatBody())
+		buf.WriteString("\n")
+	}
+
+	return buf.Bytes()
+}
+
+func (r *Request) formatBody() []byte {
+	contentType := r.Header.Get(util.HeaderContentType)
+
+	if strings.HasPrefix(contentType, util.HeaderContentTypeForm) {
+		return bytes.ReplaceAll(r.Body, []byte("&"), []byte("\n"))
+	}
+
+	if !strings.HasPrefix(contentType, util.HeaderContentTypeJson) {
+		return r.Body
+	}
+
+	var indented bytes.Buffer
+	err := json.Indent(&indented, r.Body, "", "  ")
+	if err != nil {
+		return r.Body
+	}
+
+	return indented.Bytes()
+}
@@ -0,0 +1,54 @@
+package escrow
+
+// Courier 에스크로 배송정보의 택배사 코드
+type Courier string
+
+const (
+	CourierCJ        Courier = "CJGLS"    // CJ대한통운
+	CourierEPost     Courier = "EPOST"    // 우체국택배
+	CourierHanjin    Courier = "HANJIN"   // 한진택배
+	CourierLotte     Courier = "HYUNDAI"  // 롯데택배 (구 현대택배)
+	CourierLogen     Courier = "KGB"      // 로젠택배
+	CourierKyungdong Courier = "KDEXP"    // 경동택배
+	CourierDaesin    Courier = "DAESIN"   // 대신택배
+	CourierIlyang    Courier = "ILYANG"   // 일양로지스
+	CourierChunil    Courier = "CHUNIL"   // 천일택배
+	CourierKunyoung  Courier = "KUNYOUNG" // 건영택배
+	CourierHapdong   Courier = "HDEXP"    // 합동택배
+	CourierCVSNet    Courier = "CVSNET"   // GS Postbox 편의점택배
+	CourierSelf      Courier = "SELF"     // 자체배송
+	CourierEtc       Courier = "ETC"      // 기타
+)
+
+var courierNames = map[Courier]string{
+	CourierCJ:        "CJ대한통운",
+	CourierEPost:     "우체국택배",
+	CourierHanjin:    "한진택배",
+	CourierLotte:     "롯데택배",
+	CourierLogen:     "로젠택배",
+	CourierKyungdong: "경동택배",
+	CourierDaesin:    "대신택배",
+	CourierIlyang:    "일양로지스",
+	CourierChunil:    "천일택배",
+	CourierKunyoung:  "건영택배",
+	CourierHapdong:   "합동택배",
+	CourierCVSNet:    "GS Postbox 편의점택배",
+	CourierSelf:      "자체배송",
+	CourierEtc:       "기타",
+}
+
+// String 택배사 코드
+func (c Courier) String() string {
+	return string(c)
+}
+
+// Name 택배사 이름. 알 수 없는 코드이면 빈 문자열
+func (c Courier) Name() string {
+	return courierNames[c]
+}
+
+// IsValid 아임포트에 정의된 택배사 코드인지 확인한다.
+func (c Courier) IsValid() bool {
+	_, ok := courierNames[c]
+	return ok
+}
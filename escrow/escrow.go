@@ -0,0 +1,55 @@
+package escrow
+
+import (
+	"net/http"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/iamport/go-iamport/util"
+
+	"github.com/iamport/interface/gen_src/go/v1/escrow"
+)
+
+const (
+	URLEscrows = "/escrows"
+	URLLogis   = "/logis"
+)
+
+// RegisterLogis - POST /escrows/logis/{imp_uid}
+// 에스크로 결제건의 배송정보(발송인, 수취인, 택배사, 송장번호, 발송일시)를 등록합니다.
+func RegisterLogis(client *http.Client, apiDomain string, token string, params *escrow.EscrowRequest) (*escrow.EscrowResponse, error) {
+	return callLogis(client, apiDomain, token, util.POST, params)
+}
+
+// UpdateLogis - PUT /escrows/logis/{imp_uid}
+// 등록된 에스크로 결제건의 배송정보를 수정합니다.
+func UpdateLogis(client *http.Client, apiDomain string, token string, params *escrow.EscrowRequest) (*escrow.EscrowResponse, error) {
+	return callLogis(client, apiDomain, token, util.PUT, params)
+}
+
+func callLogis(client *http.Client, apiDomain string, token string, method util.Method, params *escrow.EscrowRequest) (*escrow.EscrowResponse, error) {
+	urls := []string{apiDomain, URLEscrows, URLLogis, "/", params.GetImpUid()}
+	urlLogis := strings.Join(urls, "")
+
+	marshaler := protojson.MarshalOptions{
+		UseProtoNames: true,
+	}
+	jsonBytes, err := marshaler.Marshal(params)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := util.CallWithJson(client, token, urlLogis, method, jsonBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	escrowRes := escrow.EscrowResponse{}
+	err = protojson.Unmarshal(res, &escrowRes)
+	if err != nil {
+		return nil, err
+	}
+
+	return &escrowRes, nil
+}
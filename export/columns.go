@@ -0,0 +1,119 @@
+package export
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	TypePayment "github.com/iamport/interface/gen_src/go/v1/payment"
+)
+
+// TimeLayout CSV 에 기록하는 시각 형식
+const TimeLayout = "2006-01-02 15:04:05"
+
+// Column CSV 컬럼
+type Column struct {
+	Key string // 컬럼 식별자 (아임포트 필드명)
+	Ko  string // 한글 헤더
+	En  string // 영문 헤더
+
+	value func(pay *TypePayment.Payment, loc *time.Location) string
+}
+
+// Header 언어에 맞는 헤더
+func (c *Column) Header(lang Language) string {
+	if lang == English {
+		return c.En
+	}
+
+	return c.Ko
+}
+
+// Value 결제건의 컬럼 값. 시각은 loc 기준으로 기록한다.
+func (c *Column) Value(pay *TypePayment.Payment, loc *time.Location) string {
+	return c.value(pay, loc)
+}
+
+// AllColumns 지원하는 모든 컬럼
+var AllColumns = []*Column{
+	stringColumn("imp_uid", "아임포트 고유번호", "Imp UID", (*TypePayment.Payment).GetImpUid),
+	stringColumn("merchant_uid", "주문번호", "Merchant UID", (*TypePayment.Payment).GetMerchantUid),
+	stringColumn("name", "상품명", "Name", (*TypePayment.Payment).GetName),
+	stringColumn("status", "결제상태", "Status", (*TypePayment.Payment).GetStatus),
+	stringColumn("pay_method", "결제수단", "Pay Method", (*TypePayment.Payment).GetPayMethod),
+	stringColumn("pg_provider", "PG사", "PG Provider", (*TypePayment.Payment).GetPgProvider),
+	stringColumn("pg_tid", "PG 거래번호", "PG TID", (*TypePayment.Payment).GetPgTid),
+	stringColumn("channel", "결제환경", "Channel", (*TypePayment.Payment).GetChannel),
+	intColumn("amount", "결제금액", "Amount", (*TypePayment.Payment).GetAmount),
+	intColumn("cancel_amount", "취소금액", "Cancel Amount", (*TypePayment.Payment).GetCancelAmount),
+	stringColumn("currency", "통화", "Currency", (*TypePayment.Payment).GetCurrency),
+	stringColumn("card_name", "카드사", "Card Name", (*TypePayment.Payment).GetCardName),
+	intColumn("card_quota", "할부개월수", "Card Quota", (*TypePayment.Payment).GetCardQuota),
+	stringColumn("apply_num", "승인번호", "Apply Num", (*TypePayment.Payment).GetApplyNum),
+	stringColumn("bank_name", "은행", "Bank Name", (*TypePayment.Payment).GetBankName),
+	stringColumn("vbank_name", "가상계좌 은행", "Vbank Name", (*TypePayment.Payment).GetVbankName),
+	stringColumn("vbank_num", "가상계좌 번호", "Vbank Num", (*TypePayment.Payment).GetVbankNum),
+	stringColumn("buyer_name", "구매자", "Buyer Name", (*TypePayment.Payment).GetBuyerName),
+	stringColumn("buyer_email", "구매자 이메일", "Buyer Email", (*TypePayment.Payment).GetBuyerEmail),
+	stringColumn("buyer_tel", "구매자 전화번호", "Buyer Tel", (*TypePayment.Payment).GetBuyerTel),
+	stringColumn("customer_uid", "빌링키", "Customer UID", (*TypePayment.Payment).GetCustomerUid),
+	timeColumn("started_at", "결제시작시각", "Started At", (*TypePayment.Payment).GetStartedAt),
+	timeColumn("paid_at", "결제완료시각", "Paid At", (*TypePayment.Payment).GetPaidAt),
+	timeColumn("failed_at", "결제실패시각", "Failed At", (*TypePayment.Payment).GetFailedAt),
+	timeColumn("cancelled_at", "결제취소시각", "Cancelled At", (*TypePayment.Payment).GetCancelledAt),
+	stringColumn("fail_reason", "실패사유", "Fail Reason", (*TypePayment.Payment).GetFailReason),
+	stringColumn("cancel_reason", "취소사유", "Cancel Reason", (*TypePayment.Payment).GetCancelReason),
+	stringColumn("receipt_url", "매출전표", "Receipt URL", (*TypePayment.Payment).GetReceiptUrl),
+	stringColumn("custom_data", "추가정보", "Custom Data", (*TypePayment.Payment).GetCustomData),
+}
+
+// DefaultColumns 컬럼을 지정하지 않았을 때 기록하는 컬럼
+var DefaultColumns = []string{
+	"imp_uid", "merchant_uid", "name", "status", "pay_method", "pg_provider",
+	"amount", "cancel_amount", "currency", "buyer_name", "paid_at", "cancelled_at", "receipt_url",
+}
+
+// LookupColumn Key 로 컬럼을 찾는다. 없으면 nil
+func LookupColumn(key string) *Column {
+	for _, column := range AllColumns {
+		if column.Key == key {
+			return column
+		}
+	}
+
+	return nil
+}
+
+func stringColumn(key, ko, en string, get func(*TypePayment.Payment) string) *Column {
+	return &Column{Key: key, Ko: ko, En: en, value: func(pay *TypePayment.Payment, _ *time.Location) string {
+		return EscapeFormula(get(pay))
+	}}
+}
+
+func intColumn(key, ko, en string, get func(*TypePayment.Payment) int32) *Column {
+	return &Column{Key: key, Ko: ko, En: en, value: func(pay *TypePayment.Payment, _ *time.Location) string {
+		return strconv.Itoa(int(get(pay)))
+	}}
+}
+
+func timeColumn(key, ko, en string, get func(*TypePayment.Payment) int32) *Column {
+	return &Column{Key: key, Ko: ko, En: en, value: func(pay *TypePayment.Payment, loc *time.Location) string {
+		if get(pay) == 0 {
+			return ""
+		}
+
+		return time.Unix(int64(get(pay)), 0).In(loc).Format(TimeLayout)
+	}}
+}
+
+// EscapeFormula 스프레드시트에서 수식으로 실행되지 않도록 =, +, -, @ 로 시작하는 값 앞에 ' 를 붙인다.
+//
+// "+82-10-..." 같은 국제 전화번호도 스프레드시트에서는 수식으로 계산되고,
+// "+1+cmd|..." 처럼 숫자로 시작하는 수식도 있으므로 + 뒤에 숫자가 오더라도 ' 를 붙인다.
+func EscapeFormula(value string) string {
+	if value != "" && strings.ContainsRune("=+-@\t\r", rune(value[0])) {
+		return "'" + value
+	}
+
+	return value
+}
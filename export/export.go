@@ -0,0 +1,212 @@
+package export
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	TypePayment "github.com/iamport/interface/gen_src/go/v1/payment"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/iamport/go-iamport/iamport"
+	"github.com/iamport/go-iamport/util"
+)
+
+const (
+	ErrUnknownColumn = "iamport: unknown export column"
+	ErrUnknownFormat = "iamport: unknown export format. must be csv and jsonl"
+)
+
+// Language CSV 헤더 언어
+type Language string
+
+const (
+	Korean  Language = "ko"
+	English Language = "en"
+)
+
+// Format 내보내기 형식
+type Format string
+
+const (
+	FormatCSV   Format = "csv"
+	FormatJSONL Format = "jsonl"
+)
+
+// UTF8BOM 엑셀에서 UTF-8 CSV 를 한글이 깨지지 않게 열기 위한 BOM
+var UTF8BOM = []byte{0xEF, 0xBB, 0xBF}
+
+// Options 내보내기 조건
+type Options struct {
+	// ListOptions 조회할 결제 상태와 기간. 기간이 3개월을 넘으면 3개월 단위로 나누어 조회한다.
+	iamport.ListOptions
+
+	Columns  []string       // CSV 컬럼 (Column.Key), 비어있으면 DefaultColumns
+	Language Language       // CSV 헤더 언어, 비어있으면 한글
+	Location *time.Location // CSV 시각 기준, nil 이면 KST
+	NoBOM    bool           // CSV 앞에 UTF-8 BOM 을 붙이지 않는다.
+
+	// Progress 결제건을 하나 기록할 때마다 지금까지 기록한 건수로 호출된다.
+	Progress func(written int)
+}
+
+// Writer 결제건을 하나씩 기록한다.
+type Writer interface {
+	Write(pay *TypePayment.Payment) error
+	Flush() error
+}
+
+// CSVWriter 결제건을 CSV 한 줄씩 기록한다.
+type CSVWriter struct {
+	csv     *csv.Writer
+	columns []*Column
+	loc     *time.Location
+}
+
+// NewCSVWriter BOM 과 헤더를 기록한 CSVWriter 를 만든다.
+func NewCSVWriter(w io.Writer, opts *Options) (*CSVWriter, error) {
+	if opts == nil {
+		opts = &Options{}
+	}
+
+	keys := opts.Columns
+	if len(keys) == 0 {
+		keys = DefaultColumns
+	}
+
+	columns := make([]*Column, len(keys))
+	for i, key := range keys {
+		columns[i] = LookupColumn(key)
+		if columns[i] == nil {
+			return nil, fmt.Errorf("%s: %s", ErrUnknownColumn, key)
+		}
+	}
+
+	loc := opts.Location
+	if loc == nil {
+		loc = util.KST
+	}
+
+	if !opts.NoBOM {
+		if _, err := w.Write(UTF8BOM); err != nil {
+			return nil, err
+		}
+	}
+
+	writer := &CSVWriter{csv: csv.NewWriter(w), columns: columns, loc: loc}
+
+	header := make([]string, len(columns))
+	for i, column := range columns {
+		header[i] = column.Header(opts.Language)
+	}
+
+	if err := writer.csv.Write(header); err != nil {
+		return nil, err
+	}
+
+	return writer, nil
+}
+
+// Write 결제건 한 줄을 기록한다.
+func (w *CSVWriter) Write(pay *TypePayment.Payment) error {
+	record := make([]string, len(w.columns))
+	for i, column := range w.columns {
+		record[i] = column.Value(pay, w.loc)
+	}
+
+	return w.csv.Write(record)
+}
+
+// Flush 버퍼에 남은 내용을 기록한다.
+func (w *CSVWriter) Flush() error {
+	w.csv.Flush()
+	return w.csv.Error()
+}
+
+// JSONLWriter 결제건을 아임포트 필드명 그대로 한 줄에 하나의 JSON 객체로 기록한다. 시각은 unix timestamp 이다.
+type JSONLWriter struct {
+	w io.Writer
+}
+
+// NewJSONLWriter JSONLWriter 를 만든다.
+func NewJSONLWriter(w io.Writer) *JSONLWriter {
+	return &JSONLWriter{w: w}
+}
+
+var jsonlMarshal = protojson.MarshalOptions{UseProtoNames: true}
+
+// Write 결제건 한 줄을 기록한다.
+func (w *JSONLWriter) Write(pay *TypePayment.Payment) error {
+	body, err := jsonlMarshal.Marshal(pay)
+	if err != nil {
+		return err
+	}
+
+	// protojson 출력의 공백은 실행마다 달라질 수 있으므로 압축한다.
+	var line bytes.Buffer
+	if err := json.Compact(&line, body); err != nil {
+		return err
+	}
+	line.WriteByte('\n')
+
+	_, err = w.w.Write(line.Bytes())
+	return err
+}
+
+// Flush JSONLWriter 는 버퍼를 사용하지 않는다.
+func (w *JSONLWriter) Flush() error {
+	return nil
+}
+
+// Export 조건에 맞는 결제건을 모든 페이지에 걸쳐 조회하면서 바로 w 에 기록한다. 기록한 건수를 return 해준다.
+func Export(ctx context.Context, iam *iamport.Iamport, w io.Writer, format Format, opts *Options) (int, error) {
+	if opts == nil {
+		opts = &Options{}
+	}
+
+	var writer Writer
+	switch format {
+	case FormatCSV:
+		csvWriter, err := NewCSVWriter(w, opts)
+		if err != nil {
+			return 0, err
+		}
+		writer = csvWriter
+	case FormatJSONL:
+		writer = NewJSONLWriter(w)
+	default:
+		return 0, errors.New(ErrUnknownFormat)
+	}
+
+	written, err := Stream(ctx, iam, writer, opts)
+	if flushErr := writer.Flush(); err == nil {
+		err = flushErr
+	}
+
+	return written, err
+}
+
+// Stream 조건에 맞는 결제건을 writer 에 기록한다.
+// 기간이 3개월을 넘으면 3개월 구간씩 순서대로 조회하며, 구간 경계에서 중복 조회된 결제건은 한 번만 기록한다. (iamport.PaymentService.ForEachRange)
+func Stream(ctx context.Context, iam *iamport.Iamport, writer Writer, opts *Options) (int, error) {
+	written := 0
+	err := iam.Payments.ForEachRange(ctx, &opts.ListOptions, func(pay *TypePayment.Payment) error {
+		if err := writer.Write(pay); err != nil {
+			return err
+		}
+		written++
+
+		if opts.Progress != nil {
+			opts.Progress(written)
+		}
+
+		return nil
+	})
+
+	return written, err
+}
@@ -0,0 +1,162 @@
+package export
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	TypePayment "github.com/iamport/interface/gen_src/go/v1/payment"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/iamport/go-iamport/contract"
+	"github.com/iamport/go-iamport/iamport"
+	"github.com/iamport/go-iamport/util"
+)
+
+var base = time.Date(2021, 3, 1, 0, 0, 0, 0, time.UTC)
+
+var exportPayments = []*TypePayment.Payment{
+	{ImpUid: "imp_1", MerchantUid: "order_1", Name: "티셔츠, 2장", Status: string(util.StatusPaid), Amount: 20000,
+		StartedAt: int32(base.Unix()), PaidAt: int32(base.Add(time.Minute).Unix()), BuyerName: "=HYPERLINK(\"x\")"},
+	{ImpUid: "imp_2", MerchantUid: "order_2", Name: "양말", Status: string(util.StatusCancelled), Amount: 3000, CancelAmount: 3000,
+		StartedAt: int32(base.Add(100 * 24 * time.Hour).Unix())},
+}
+
+// newExportIamport from ~ to 사이에 시작된 결제건을 응답하는 로컬 서버에 연결한다.
+func newExportIamport(t *testing.T) (*iamport.Iamport, *contract.Server) {
+	server := contract.NewServer(func(w http.ResponseWriter, r *http.Request) {
+		from, _ := strconv.ParseInt(r.URL.Query().Get("from"), 10, 64)
+		to, _ := strconv.ParseInt(r.URL.Query().Get("to"), 10, 64)
+
+		page := &TypePayment.PaymentPage{}
+		for _, pay := range exportPayments {
+			if int64(pay.StartedAt) >= from && int64(pay.StartedAt) <= to {
+				page.List = append(page.List, pay)
+			}
+		}
+
+		contract.Respond(w, page)
+	})
+	t.Cleanup(server.Close)
+
+	auth, err := server.Authenticate()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return iamport.NewIamportWithAuthenticate(auth), server
+}
+
+func exportRange() iamport.ListOptions {
+	return iamport.ListOptions{From: base, To: base.AddDate(0, 6, 0)}
+}
+
+func TestExportCSV(t *testing.T) {
+	iam, server := newExportIamport(t)
+
+	var progress []int
+	var buf bytes.Buffer
+	n, err := Export(context.Background(), iam, &buf, FormatCSV, &Options{
+		ListOptions: exportRange(),
+		Columns:     []string{"imp_uid", "name", "amount", "paid_at", "buyer_name"},
+		Progress:    func(written int) { progress = append(progress, written) },
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, n)
+	assert.Equal(t, []int{1, 2}, progress)
+	// 3개월 구간 2개 + 토큰 발급
+	assert.Len(t, server.Requests(), 3)
+
+	assert.True(t, bytes.HasPrefix(buf.Bytes(), UTF8BOM))
+	assert.Equal(t, strings.Join([]string{
+		"아임포트 고유번호,상품명,결제금액,결제완료시각,구매자",
+		`imp_1,"티셔츠, 2장",20000,2021-03-01 09:01:00,"'=HYPERLINK(""x"")"`,
+		"imp_2,양말,3000,,",
+		"",
+	}, "\n"), string(buf.Bytes()[len(UTF8BOM):]))
+}
+
+func TestExportCSVEnglishUTC(t *testing.T) {
+	iam, _ := newExportIamport(t)
+
+	var buf bytes.Buffer
+	_, err := Export(context.Background(), iam, &buf, FormatCSV, &Options{
+		ListOptions: exportRange(),
+		Columns:     []string{"imp_uid", "started_at"},
+		Language:    English,
+		Location:    time.UTC,
+		NoBOM:       true,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "Imp UID,Started At\nimp_1,2021-03-01 00:00:00\nimp_2,2021-06-09 00:00:00\n", buf.String())
+}
+
+func TestExportJSONL(t *testing.T) {
+	iam, _ := newExportIamport(t)
+
+	var buf bytes.Buffer
+	n, err := Export(context.Background(), iam, &buf, FormatJSONL, &Options{ListOptions: exportRange()})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, n)
+
+	lines := strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n")
+	assert.Len(t, lines, 2)
+	assert.True(t, strings.HasPrefix(lines[0], `{"amount":20000,`))
+	assert.Contains(t, lines[1], `"imp_uid":"imp_2"`)
+	assert.Contains(t, lines[1], `"cancel_amount":3000`)
+}
+
+func TestExportUnknownColumn(t *testing.T) {
+	iam, server := newExportIamport(t)
+
+	var buf bytes.Buffer
+	_, err := Export(context.Background(), iam, &buf, FormatCSV, &Options{Columns: []string{"imp_uid", "unknown"}})
+	assert.EqualError(t, err, ErrUnknownColumn+": unknown")
+	assert.Len(t, server.Requests(), 1)
+}
+
+func TestExportUnknownFormat(t *testing.T) {
+	iam, server := newExportIamport(t)
+
+	var buf bytes.Buffer
+	_, err := Export(context.Background(), iam, &buf, Format("xlsx"), &Options{ListOptions: exportRange()})
+	assert.EqualError(t, err, ErrUnknownFormat)
+	assert.Zero(t, buf.Len())
+	assert.Len(t, server.Requests(), 1)
+}
+
+func TestExportFromFebruary(t *testing.T) {
+	iam, server := newExportIamport(t)
+
+	// 2월 1일 ~ 5월 1일은 89일이다. 90일 단위로 나누면 첫 구간이 조회 기간 제한에 걸린다.
+	from := time.Date(2021, 2, 1, 0, 0, 0, 0, time.UTC)
+	var buf bytes.Buffer
+	n, err := Export(context.Background(), iam, &buf, FormatJSONL, &Options{
+		ListOptions: iamport.ListOptions{From: from, To: from.AddDate(1, 0, 0)},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, n)
+	// 3개월 구간 4개 + 토큰 발급
+	assert.Len(t, server.Requests(), 5)
+}
+
+func TestEscapeFormula(t *testing.T) {
+	assert.Equal(t, "'=SUM(A1)", EscapeFormula("=SUM(A1)"))
+	assert.Equal(t, "'-1", EscapeFormula("-1"))
+	assert.Equal(t, "'@A1", EscapeFormula("@A1"))
+	// 국제 전화번호도 수식으로 계산되므로 escape 한다.
+	assert.Equal(t, "'+82-10-1234-5678", EscapeFormula("+82-10-1234-5678"))
+	assert.Equal(t, "'+1+cmd|' /C calc'!A0", EscapeFormula("+1+cmd|' /C calc'!A0"))
+	assert.Equal(t, "010-1234-5678", EscapeFormula("010-1234-5678"))
+	assert.Equal(t, "", EscapeFormula(""))
+}
+
+func TestDefaultColumnsExist(t *testing.T) {
+	for _, key := range DefaultColumns {
+		assert.NotNil(t, LookupColumn(key), key)
+	}
+}
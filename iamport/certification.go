@@ -0,0 +1,121 @@
+package iamport
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/iamport/go-iamport/certification"
+	"github.com/iamport/go-iamport/util"
+)
+
+const (
+	ErrNotCertified                  = "iamport: certification is not completed"
+	ErrCertificationMerchantUIDDiff  = "iamport: merchant_uid of certification is different"
+	ErrCertificationWithoutBirthDate = "iamport: certification has no birth date"
+)
+
+// CertificationService 본인인증 API
+type CertificationService service
+
+// UnderageError 본인인증한 사용자의 나이가 기준보다 어린 경우
+type UnderageError struct {
+	Age    int
+	MinAge int
+}
+
+func (e *UnderageError) Error() string {
+	return fmt.Sprintf("iamport: certified user is %d years old, must be at least %d", e.Age, e.MinAge)
+}
+
+// CertificationRequirement 본인인증 결과 확인 조건
+type CertificationRequirement struct {
+	MerchantUID string    // 지정하면 본인인증 요청 시 전달한 merchant_uid 와 같은지 확인한다.
+	MinAge      int       // 0보다 크면 만 나이가 MinAge 이상인지 확인한다.
+	Now         time.Time // 나이 계산 기준 시각, 비어있으면 현재 시각
+}
+
+// Get 아임포트 고유번호로 본인인증 결과를 가져온다.
+//
+// GET /certifications/{imp_uid}
+func (s *CertificationService) Get(impUID string) (*certification.Certification, error) {
+	return s.call(impUID, certification.GetByImpUID)
+}
+
+// Delete 아임포트에 저장된 본인인증 결과(개인정보)를 삭제한다.
+// 가맹점에 필요한 정보를 저장한 뒤 삭제하는 것을 권장한다.
+//
+// DELETE /certifications/{imp_uid}
+func (s *CertificationService) Delete(impUID string) (*certification.Certification, error) {
+	return s.call(impUID, certification.Delete)
+}
+
+// Verify 본인인증 결과를 가져와 인증 완료 여부, merchant_uid, 나이를 확인한다.
+// 나이가 기준보다 어리면 본인인증 결과와 함께 *UnderageError 를 return 해준다.
+//
+// GET /certifications/{imp_uid}
+func (s *CertificationService) Verify(impUID string, req CertificationRequirement) (*certification.Certification, error) {
+	cert, err := s.Get(impUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return cert, VerifyCertification(cert, req)
+}
+
+// VerifyCertification 이미 가져온 본인인증 결과를 req 조건으로 확인한다.
+func VerifyCertification(cert *certification.Certification, req CertificationRequirement) error {
+	if cert == nil || !cert.Certified {
+		return errors.New(ErrNotCertified)
+	}
+
+	if req.MerchantUID != "" && cert.MerchantUID != req.MerchantUID {
+		return errors.New(ErrCertificationMerchantUIDDiff)
+	}
+
+	if req.MinAge <= 0 {
+		return nil
+	}
+
+	if cert.Birth.IsZero() {
+		return errors.New(ErrCertificationWithoutBirthDate)
+	}
+
+	now := req.Now
+	if now.IsZero() {
+		now = time.Now()
+	}
+
+	if age := cert.Age(now); age < req.MinAge {
+		return &UnderageError{Age: age, MinAge: req.MinAge}
+	}
+
+	return nil
+}
+
+type certificationCall func(*http.Client, string, string, *certification.CertificationRequest) (*certification.CertificationResponse, error)
+
+func (s *CertificationService) call(impUID string, call certificationCall) (*certification.Certification, error) {
+	if impUID == "" {
+		return nil, errors.New(ErrMustExistImpUID)
+	}
+
+	token, err := s.iamport.Authenticate.GetToken()
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := call(s.iamport.Authenticate.Client, s.iamport.Authenticate.APIUrl, token, &certification.CertificationRequest{
+		ImpUID: impUID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if res.Code != util.CodeOK {
+		return nil, errors.New(res.Message)
+	}
+
+	return res.Response, nil
+}
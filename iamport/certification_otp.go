@@ -0,0 +1,252 @@
+package iamport
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/iamport/go-iamport/certification"
+	"github.com/iamport/go-iamport/util"
+)
+
+const (
+	ErrMustExistOTPName        = "iamport: name must be exist"
+	ErrMustExistOTPPhone       = "iamport: phone must be exist"
+	ErrMustExistOTPBirth       = "iamport: birth must be exist"
+	ErrInvalidOTPGenderDigit   = "iamport: gender digit must be between 1 and 8"
+	ErrInvalidOTPCarrier       = "iamport: carrier is invalid"
+	ErrMustExistOTP            = "iamport: otp must be exist"
+	ErrOTPSessionAlreadyClosed = "iamport: otp session is already confirmed"
+	ErrEmptyOTPConfirmResponse = "iamport: otp confirm response is empty"
+
+	// DefaultOTPTimeout SMS 인증번호 유효 시간
+	DefaultOTPTimeout = 3 * time.Minute
+)
+
+// OTPErrorReason 인증번호 확인 실패 사유
+type OTPErrorReason string
+
+const (
+	OTPWrongCode OTPErrorReason = "wrong_code" // 인증번호가 틀린 경우
+	OTPExpired   OTPErrorReason = "expired"    // 유효 시간이 지난 경우
+)
+
+// OTPError 인증번호 확인 실패
+type OTPError struct {
+	Reason  OTPErrorReason
+	ImpUID  string
+	Message string // 아임포트 응답 메시지, 요청 전에 만료를 확인한 경우 비어있다.
+}
+
+func (e *OTPError) Error() string {
+	if e.Message == "" {
+		return fmt.Sprintf("iamport: otp of %s %s", e.ImpUID, e.Reason)
+	}
+
+	return fmt.Sprintf("iamport: otp of %s %s: %s", e.ImpUID, e.Reason, e.Message)
+}
+
+// IsOTPExpired 인증번호 유효 시간이 지나 실패했는지 확인한다.
+func IsOTPExpired(err error) bool {
+	var otpErr *OTPError
+	return errors.As(err, &otpErr) && otpErr.Reason == OTPExpired
+}
+
+// IsWrongOTP 인증번호가 틀려 실패했는지 확인한다. 같은 세션으로 다시 확인할 수 있다.
+func IsWrongOTP(err error) bool {
+	var otpErr *OTPError
+	return errors.As(err, &otpErr) && otpErr.Reason == OTPWrongCode
+}
+
+// OTPIdentity SMS 본인인증 대상자 정보
+type OTPIdentity struct {
+	Name        string
+	Phone       string
+	Birth       time.Time // 생년월일, 날짜만 사용한다.
+	GenderDigit int       // 주민등록번호 뒷자리 첫 번째 숫자 (1~8)
+	Carrier     certification.Carrier
+
+	Company     string // SMS 에 표시할 서비스명
+	PG          string // 여러 본인인증 PG사를 사용하는 경우 PG사 구분
+	MerchantUID string
+}
+
+// OTPSession SMS 본인인증 요청부터 인증번호 확인까지의 세션
+// 인증번호 확인(Confirm)은 유효 시간 안에 여러 번 시도할 수 있으며, 성공하면 세션이 닫힌다.
+type OTPSession struct {
+	ImpUID      string
+	RequestedAt time.Time // 인증번호 발송 시각, ResumeOTPSession 으로 만든 세션은 알 수 없으므로 비어있다.
+	ExpiresAt   time.Time
+
+	service *CertificationService
+	now     func() time.Time
+
+	mu        sync.Mutex
+	attempts  int
+	confirmed *certification.Certification
+}
+
+// RequestOTP 휴대폰으로 SMS 인증번호를 발송하고 세션을 시작한다.
+//
+// POST /certifications/otp/request
+func (s *CertificationService) RequestOTP(identity OTPIdentity) (*OTPSession, error) {
+	req, err := identity.request()
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := s.iamport.Authenticate.GetToken()
+	if err != nil {
+		return nil, err
+	}
+
+	// 유효 시간은 아임포트가 인증번호를 발송한 시각부터 계산되므로 응답을 받은 시각이 아니라 요청 직전 시각을 기준으로 한다.
+	now := time.Now()
+	res, err := certification.RequestOTP(s.iamport.Authenticate.Client, s.iamport.Authenticate.APIUrl, token, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.Code != util.CodeOK {
+		return nil, errors.New(res.Message)
+	}
+
+	if res.Response == nil || res.Response.ImpUID == "" {
+		return nil, errors.New(ErrMustExistImpUID)
+	}
+
+	session := s.ResumeOTPSession(res.Response.ImpUID, now.Add(DefaultOTPTimeout))
+	session.RequestedAt = now
+
+	return session, nil
+}
+
+// ResumeOTPSession 요청과 확인을 서로 다른 프로세스에서 처리하는 경우 저장해 둔 imp_uid 와 만료 시각으로 세션을 다시 만든다.
+func (s *CertificationService) ResumeOTPSession(impUID string, expiresAt time.Time) *OTPSession {
+	return &OTPSession{
+		ImpUID:    impUID,
+		ExpiresAt: expiresAt,
+		service:   s,
+		now:       time.Now,
+	}
+}
+
+// Expired 유효 시간이 지났는지 확인한다.
+func (o *OTPSession) Expired() bool {
+	return !o.now().Before(o.ExpiresAt)
+}
+
+// Remaining 남은 유효 시간. 지났으면 0
+func (o *OTPSession) Remaining() time.Duration {
+	remaining := o.ExpiresAt.Sub(o.now())
+	if remaining < 0 {
+		return 0
+	}
+
+	return remaining
+}
+
+// Attempts 인증번호 확인을 시도한 횟수
+func (o *OTPSession) Attempts() int {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	return o.attempts
+}
+
+// Confirm 사용자가 입력한 인증번호로 본인인증을 완료하고 본인인증 결과를 return 해준다.
+// 인증번호가 틀리거나 유효 시간이 지나면 *OTPError 를 return 해준다. (IsWrongOTP, IsOTPExpired)
+// 유효 시간이 지난 경우 요청을 보내지 않는다.
+//
+// 아임포트는 거절 사유를 응답 메시지로만 알려주므로 만료 여부는 ExpiresAt 으로 판단하며,
+// 유효 시간 안에 거절된 경우는 모두 OTPWrongCode 로 본다.
+//
+// POST /certifications/otp/confirm/{imp_uid}
+func (o *OTPSession) Confirm(otp string) (*certification.Certification, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	if o.confirmed != nil {
+		return nil, errors.New(ErrOTPSessionAlreadyClosed)
+	}
+
+	otp = strings.TrimSpace(otp)
+	if otp == "" {
+		return nil, errors.New(ErrMustExistOTP)
+	}
+
+	if o.Expired() {
+		return nil, &OTPError{Reason: OTPExpired, ImpUID: o.ImpUID}
+	}
+
+	token, err := o.service.iamport.Authenticate.GetToken()
+	if err != nil {
+		return nil, err
+	}
+
+	o.attempts++
+	res, err := certification.ConfirmOTP(o.service.iamport.Authenticate.Client, o.service.iamport.Authenticate.APIUrl, token, &certification.OTPConfirmRequest{
+		ImpUID: o.ImpUID,
+		OTP:    otp,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if res.Code != util.CodeOK {
+		reason := OTPWrongCode
+		if o.Expired() {
+			reason = OTPExpired
+		}
+
+		return nil, &OTPError{Reason: reason, ImpUID: o.ImpUID, Message: res.Message}
+	}
+
+	// 성공 응답에 본인인증 결과가 없으면 세션을 닫지 않고 다시 확인할 수 있도록 한다.
+	if res.Response == nil {
+		return nil, errors.New(ErrEmptyOTPConfirmResponse)
+	}
+
+	o.confirmed = res.Response
+	return res.Response, nil
+}
+
+// request 아임포트 요청 파라미터로 변환한다.
+// 아임포트는 KT 를 KTF 로 표기하고 알뜰폰은 is_mvno 로 구분한다.
+func (i OTPIdentity) request() (*certification.OTPRequest, error) {
+	switch {
+	case i.Name == "":
+		return nil, errors.New(ErrMustExistOTPName)
+	case i.Phone == "":
+		return nil, errors.New(ErrMustExistOTPPhone)
+	case i.Birth.IsZero():
+		return nil, errors.New(ErrMustExistOTPBirth)
+	case i.GenderDigit < 1 || i.GenderDigit > 8:
+		return nil, errors.New(ErrInvalidOTPGenderDigit)
+	}
+
+	carrier := certification.Carrier(strings.TrimSuffix(string(i.Carrier), "_MVNO"))
+	otpCarriers := map[certification.Carrier]string{
+		certification.CarrierSKT: "SKT",
+		certification.CarrierKT:  "KTF",
+		certification.CarrierLGT: "LGT",
+	}
+	otpCarrier, ok := otpCarriers[carrier]
+	if !ok {
+		return nil, errors.New(ErrInvalidOTPCarrier)
+	}
+
+	return &certification.OTPRequest{
+		Name:        i.Name,
+		Phone:       strings.Replace(i.Phone, "-", "", -1),
+		Birth:       i.Birth.Format("20060102"),
+		GenderDigit: fmt.Sprint(i.GenderDigit),
+		Carrier:     otpCarrier,
+		IsMVNO:      i.Carrier.IsMVNO(),
+		Company:     i.Company,
+		Pg:          i.PG,
+		MerchantUID: i.MerchantUID,
+	}, nil
+}
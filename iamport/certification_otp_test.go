@@ -0,0 +1,169 @@
+package iamport
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/iamport/go-iamport/certification"
+	"github.com/iamport/go-iamport/contract"
+)
+
+var otpIdentity = OTPIdentity{
+	Name:        "홍길동",
+	Phone:       "010-1234-1234",
+	Birth:       time.Date(1990, 1, 1, 0, 0, 0, 0, time.UTC),
+	GenderDigit: 1,
+	Carrier:     certification.CarrierLGTMVNO,
+}
+
+// otpServer 인증번호 123456 만 통과시킨다.
+func otpServer(w http.ResponseWriter, r *http.Request) {
+	switch r.URL.Path {
+	case "/certifications/otp/request":
+		contract.Respond(w, &certification.OTPRequested{ImpUID: "imp_otp"})
+	case "/certifications/otp/confirm/imp_otp":
+		var body map[string]string
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		if body["otp"] != "123456" {
+			contract.RespondError(w, "인증번호가 일치하지 않습니다.")
+			return
+		}
+		contract.Respond(w, json.RawMessage(certificationJSON))
+	default:
+		contract.RespondError(w, "인증번호 입력시간이 만료되었습니다.")
+	}
+}
+
+func TestCertificationsOTP(t *testing.T) {
+	iamport, server := newContractIamport(t, otpServer)
+
+	session, err := iamport.Certifications.RequestOTP(otpIdentity)
+	assert.NoError(t, err)
+	assert.Equal(t, "imp_otp", session.ImpUID)
+	assert.Equal(t, DefaultOTPTimeout, session.ExpiresAt.Sub(session.RequestedAt))
+	assert.False(t, session.Expired())
+	assert.InDelta(t, DefaultOTPTimeout.Seconds(), session.Remaining().Seconds(), 1)
+	assert.JSONEq(t, `{"name":"홍길동","phone":"01012341234","birth":"19900101","gender_digit":"1","carrier":"LGT","is_mvno":true}`,
+		string(server.LastRequest().Body))
+
+	_, err = session.Confirm("000000")
+	assert.True(t, IsWrongOTP(err))
+	assert.EqualError(t, err, "iamport: otp of imp_otp wrong_code: 인증번호가 일치하지 않습니다.")
+
+	cert, err := session.Confirm(" 123456 ")
+	assert.NoError(t, err)
+	assert.True(t, cert.Certified)
+	assert.Equal(t, 2, session.Attempts())
+
+	_, err = session.Confirm("123456")
+	assert.EqualError(t, err, ErrOTPSessionAlreadyClosed)
+}
+
+func TestOTPSessionExpired(t *testing.T) {
+	iamport, server := newContractIamport(t, otpServer)
+
+	expiresAt := time.Date(2021, 3, 1, 0, 3, 0, 0, time.UTC)
+	session := iamport.Certifications.ResumeOTPSession("imp_otp", expiresAt)
+	session.now = func() time.Time { return expiresAt }
+
+	assert.True(t, session.Expired())
+	assert.Equal(t, time.Duration(0), session.Remaining())
+
+	_, err := session.Confirm("123456")
+	assert.True(t, IsOTPExpired(err))
+	// 만료된 세션은 요청을 보내지 않는다.
+	assert.Len(t, server.Requests(), 1)
+	assert.Equal(t, 0, session.Attempts())
+
+	// 저장해 둔 세션은 발송 시각을 알 수 없다.
+	assert.True(t, session.RequestedAt.IsZero())
+
+	// 요청하는 동안 유효 시간이 지나 거절된 경우
+	calls := 0
+	expiring := iamport.Certifications.ResumeOTPSession("imp_other", expiresAt)
+	expiring.now = func() time.Time {
+		calls++
+		if calls == 1 {
+			return expiresAt.Add(-time.Second)
+		}
+		return expiresAt
+	}
+	_, err = expiring.Confirm("123456")
+	assert.True(t, IsOTPExpired(err))
+	assert.False(t, IsWrongOTP(err))
+
+	// 유효 시간 안에 거절되면 응답 메시지와 관계없이 인증번호가 틀린 것으로 본다.
+	rejected := iamport.Certifications.ResumeOTPSession("imp_other", time.Now().Add(time.Minute))
+	_, err = rejected.Confirm("123456")
+	assert.True(t, IsWrongOTP(err))
+}
+
+func TestCertificationsRequestOTPExpiresFromRequest(t *testing.T) {
+	var received time.Time
+	iamport, _ := newContractIamport(t, func(w http.ResponseWriter, r *http.Request) {
+		received = time.Now()
+		time.Sleep(50 * time.Millisecond)
+		contract.Respond(w, &certification.OTPRequested{ImpUID: "imp_otp"})
+	})
+
+	session, err := iamport.Certifications.RequestOTP(otpIdentity)
+	assert.NoError(t, err)
+	// 응답을 기다린 시간만큼 유효 시간이 늘어나지 않는다.
+	assert.False(t, session.ExpiresAt.After(received.Add(DefaultOTPTimeout)))
+	assert.False(t, session.RequestedAt.After(received))
+}
+
+func TestOTPSessionConfirmEmptyResponse(t *testing.T) {
+	var empty bool
+	iamport, _ := newContractIamport(t, func(w http.ResponseWriter, r *http.Request) {
+		if empty {
+			contract.Respond(w, nil)
+			return
+		}
+		otpServer(w, r)
+	})
+
+	session, err := iamport.Certifications.RequestOTP(otpIdentity)
+	assert.NoError(t, err)
+
+	empty = true
+	cert, err := session.Confirm("123456")
+	assert.EqualError(t, err, ErrEmptyOTPConfirmResponse)
+	assert.Nil(t, cert)
+
+	// 세션이 닫히지 않았으므로 다시 확인할 수 있다.
+	empty = false
+	cert, err = session.Confirm("123456")
+	assert.NoError(t, err)
+	assert.True(t, cert.Certified)
+}
+
+func TestCertificationsRequestOTPValidation(t *testing.T) {
+	iamport, _ := newContractIamport(t, otpServer)
+
+	invalid := func(modify func(*OTPIdentity)) OTPIdentity {
+		identity := otpIdentity
+		modify(&identity)
+		return identity
+	}
+
+	for err, identity := range map[string]OTPIdentity{
+		ErrMustExistOTPName:      invalid(func(i *OTPIdentity) { i.Name = "" }),
+		ErrMustExistOTPPhone:     invalid(func(i *OTPIdentity) { i.Phone = "" }),
+		ErrMustExistOTPBirth:     invalid(func(i *OTPIdentity) { i.Birth = time.Time{} }),
+		ErrInvalidOTPGenderDigit: invalid(func(i *OTPIdentity) { i.GenderDigit = 9 }),
+		ErrInvalidOTPCarrier:     invalid(func(i *OTPIdentity) { i.Carrier = "UNKNOWN" }),
+	} {
+		_, got := iamport.Certifications.RequestOTP(identity)
+		assert.EqualError(t, got, err)
+	}
+
+	req, err := invalid(func(i *OTPIdentity) { i.Carrier = certification.CarrierKT }).request()
+	assert.NoError(t, err)
+	assert.Equal(t, "KTF", req.Carrier)
+	assert.False(t, req.IsMVNO)
+}
@@ -0,0 +1,104 @@
+package iamport
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/iamport/go-iamport/certification"
+	"github.com/iamport/go-iamport/contract"
+)
+
+const certificationJSON = `{
+	"imp_uid": "imp_1",
+	"merchant_uid": "signup_1",
+	"pg_provider": "danal",
+	"name": "홍길동",
+	"gender": "male",
+	"birth": 631119600,
+	"birthday": "1990-01-01",
+	"foreigner": false,
+	"phone": "01012341234",
+	"carrier": "SKT_MVNO",
+	"certified": true,
+	"certified_at": 1609459200,
+	"unique_key": "ci_value",
+	"unique_in_site": "di_value"
+}`
+
+func respondCertification(w http.ResponseWriter, r *http.Request) {
+	contract.Respond(w, json.RawMessage(certificationJSON))
+}
+
+func TestCertificationUnmarshal(t *testing.T) {
+	cert := &certification.Certification{}
+	assert.NoError(t, json.Unmarshal([]byte(certificationJSON), cert))
+
+	assert.Equal(t, certification.GenderMale, cert.Gender)
+	assert.Equal(t, certification.CarrierSKTMVNO, cert.Carrier)
+	assert.True(t, cert.Carrier.IsMVNO())
+	assert.Equal(t, "1990-01-01 00:00:00 +0900", cert.Birth.Format("2006-01-02 15:04:05 -0700"))
+	assert.Equal(t, int64(1609459200), cert.CertifiedAt.Unix())
+	assert.Equal(t, "ci_value", cert.CI)
+	assert.Equal(t, "di_value", cert.DI)
+
+	// birthday 가 없으면 birth 를 KST 날짜로 사용한다.
+	noBirthday := &certification.Certification{}
+	assert.NoError(t, json.Unmarshal([]byte(`{"birth": 631119600}`), noBirthday))
+	assert.True(t, cert.Birth.Equal(noBirthday.Birth))
+}
+
+func TestCertificationAge(t *testing.T) {
+	kst := time.FixedZone("KST", 9*60*60)
+	cert := &certification.Certification{Birth: time.Date(2005, 3, 2, 0, 0, 0, 0, kst)}
+
+	assert.Equal(t, 18, cert.Age(time.Date(2024, 3, 1, 23, 59, 59, 0, kst)))
+	assert.Equal(t, 19, cert.Age(time.Date(2024, 3, 2, 0, 0, 0, 0, kst)))
+	// UTC 3월 1일 15시는 KST 3월 2일 0시
+	assert.True(t, cert.IsAtLeast(19, time.Date(2024, 3, 1, 15, 0, 0, 0, time.UTC)))
+	assert.Equal(t, -1, (&certification.Certification{}).Age(time.Now()))
+}
+
+func TestCertificationsGetAndDelete(t *testing.T) {
+	iamport, server := newContractIamport(t, respondCertification)
+
+	cert, err := iamport.Certifications.Get("imp_1")
+	assert.NoError(t, err)
+	assert.Equal(t, "홍길동", cert.Name)
+	assert.Equal(t, "GET /certifications/imp_1", server.LastRequest().Method+" "+server.LastRequest().Path)
+
+	_, err = iamport.Certifications.Delete("imp_1")
+	assert.NoError(t, err)
+	assert.Equal(t, "DELETE", server.LastRequest().Method)
+
+	_, err = iamport.Certifications.Get("")
+	assert.EqualError(t, err, ErrMustExistImpUID)
+}
+
+func TestCertificationsVerify(t *testing.T) {
+	iamport, _ := newContractIamport(t, respondCertification)
+	now := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	cert, err := iamport.Certifications.Verify("imp_1", CertificationRequirement{MerchantUID: "signup_1", MinAge: 19, Now: now})
+	assert.NoError(t, err)
+	assert.Equal(t, "imp_1", cert.ImpUID)
+
+	cert, err = iamport.Certifications.Verify("imp_1", CertificationRequirement{MinAge: 40, Now: now})
+	assert.NotNil(t, cert)
+	var underage *UnderageError
+	assert.True(t, errors.As(err, &underage))
+	assert.Equal(t, 31, underage.Age)
+
+	_, err = iamport.Certifications.Verify("imp_1", CertificationRequirement{MerchantUID: "signup_2"})
+	assert.EqualError(t, err, ErrCertificationMerchantUIDDiff)
+}
+
+func TestVerifyCertification(t *testing.T) {
+	assert.EqualError(t, VerifyCertification(&certification.Certification{}, CertificationRequirement{}), ErrNotCertified)
+	assert.EqualError(t, VerifyCertification(&certification.Certification{Certified: true}, CertificationRequirement{MinAge: 19}), ErrCertificationWithoutBirthDate)
+	assert.NoError(t, VerifyCertification(&certification.Certification{Certified: true}, CertificationRequirement{}))
+}
@@ -0,0 +1,265 @@
+package iamport
+
+import (
+	"time"
+
+	TypePayment "github.com/iamport/interface/gen_src/go/v1/payment"
+	TypeSubscribe "github.com/iamport/interface/gen_src/go/v1/subscribe"
+	TypeSubscribeCust "github.com/iamport/interface/gen_src/go/v1/subscribe_customers"
+
+	"github.com/iamport/go-iamport/util"
+)
+
+// GetPaymentImpUID imp_uid로 결제 정보 가져오기
+//
+// Deprecated: Payments.Get 을 사용하세요.
+func (iamport *Iamport) GetPaymentImpUID(iuid string) (*TypePayment.Payment, error) {
+	return iamport.Payments.Get(iuid)
+}
+
+// GetPaymentsImpUIDs 여러개의 imp_uid로 결제 정보 가져오기
+//
+// Deprecated: Payments.GetMultiple 을 사용하세요.
+func (iamport *Iamport) GetPaymentsImpUIDs(iuids []string) ([]*TypePayment.Payment, error) {
+	return iamport.Payments.GetMultiple(iuids)
+}
+
+// GetPaymentMerchantUID merchant_uid로 결제 정보 가져오기
+//
+// Deprecated: Payments.FindByMerchantUID 를 사용하세요.
+func (iamport *Iamport) GetPaymentMerchantUID(muid string, status util.PaymentStatus, sorting util.Sort) (*TypePayment.Payment, error) {
+	return iamport.Payments.FindByMerchantUID(muid, status, sorting)
+}
+
+// GetPaymentsMerchantUID merchant_uid로 모든 결제 정보 가져오기
+//
+// Deprecated: Payments.FindAllByMerchantUID 를 사용하세요.
+func (iamport *Iamport) GetPaymentsMerchantUID(muid string, status util.PaymentStatus, sorting util.Sort, page int) (*TypePayment.PaymentPage, error) {
+	return iamport.Payments.FindAllByMerchantUID(muid, status, sorting, page)
+}
+
+// GetPaymentsStatus 결제 상태에 따른 결제 정보들 가져오기
+//
+// Deprecated: Payments.ListByStatus 를 사용하세요.
+func (iamport *Iamport) GetPaymentsStatus(status util.PaymentStatus, page int, limit int, from time.Time, to time.Time, sorting util.Sort) (*TypePayment.PaymentPage, error) {
+	return iamport.Payments.ListByStatus(status, page, limit, from, to, sorting)
+}
+
+// GetPaymentBalanceImpUID imp_uid로 결제수단별 금액 상세정보 가져오기
+//
+// Deprecated: Payments.GetBalance 를 사용하세요.
+func (iamport *Iamport) GetPaymentBalanceImpUID(iuid string) (*TypePayment.PaymentBalance, error) {
+	return iamport.Payments.GetBalance(iuid)
+}
+
+// CancelPaymentImpUID imp_uid로 결제 취소하기
+//
+// Deprecated: Payments.Cancel 을 사용하세요.
+func (iamport *Iamport) CancelPaymentImpUID(iuid string, merchantUID string, amount float64, taxFree float64, checkSum float64, reason string, refundHolder string, refundBank string, refundAccount string) (*TypePayment.Payment, error) {
+	return iamport.Payments.Cancel(iuid, merchantUID, amount, taxFree, checkSum, reason, refundHolder, refundBank, refundAccount)
+}
+
+// PreparePayment 결제 정보 사전 등록하기
+//
+// Deprecated: Payments.Prepare 를 사용하세요.
+func (iamport *Iamport) PreparePayment(merchantUID string, amount float64) (*TypePayment.Prepare, error) {
+	return iamport.Payments.Prepare(merchantUID, amount)
+}
+
+// GetPreparePayment 사전 등록된 결제 정보 보기
+//
+// Deprecated: Payments.GetPrepared 를 사용하세요.
+func (iamport *Iamport) GetPreparePayment(merchantUID string) (*TypePayment.Prepare, error) {
+	return iamport.Payments.GetPrepared(merchantUID)
+}
+
+// OnetimePayment ActiveX 없는 비인증결제
+//
+// Deprecated: Subscribe.Onetime 을 사용하세요.
+func (iamport *Iamport) OnetimePayment(
+	merchantUID string,
+	amount, taxFree int32,
+	cardNumber, expiry, birth, pwd_2digit string,
+	customerUid, pg, name string,
+	buyerName, buyerEmail, buyerTel, buyerAddr, buyerPostcode string,
+	cardQuota int32, interestFreeByMerchant bool,
+	customData, noticeUrl string,
+) (*TypePayment.Payment, error) {
+	return iamport.Subscribe.Onetime(&OnetimePaymentRequest{
+		MerchantUID: merchantUID,
+		Amount:      amount,
+		TaxFree:     optionalInt32(taxFree),
+		CustomerUID: customerUid,
+		PG:          pg,
+		Name:        name,
+		Card: Card{
+			Number:    cardNumber,
+			Expiry:    expiry,
+			Birth:     birth,
+			Pwd2Digit: pwd_2digit,
+		},
+		Buyer: Buyer{
+			Name:     buyerName,
+			Email:    buyerEmail,
+			Tel:      buyerTel,
+			Addr:     buyerAddr,
+			Postcode: buyerPostcode,
+		},
+		Installment: optionalInstallment(cardQuota, interestFreeByMerchant),
+		CustomData:  customData,
+		Notice:      Notice{URL: noticeUrl},
+	})
+}
+
+// AgainPayment 이전 결제 데이터를 이용한 재결제
+//
+// Deprecated: Subscribe.Again 을 사용하세요.
+func (iamport *Iamport) AgainPayment(
+	customerUID, merchantUID string,
+	amount, taxFree int32,
+	name string,
+	buyerName, buyerEmail, buyerTel, buyerAddr, buyerPostcode string,
+	cardQuota int32, interestFreeByMerchant bool,
+	customData, noticeUrl string,
+) (*TypePayment.Payment, error) {
+	return iamport.Subscribe.Again(&AgainPaymentRequest{
+		CustomerUID: customerUID,
+		MerchantUID: merchantUID,
+		Amount:      amount,
+		TaxFree:     optionalInt32(taxFree),
+		Name:        name,
+		Buyer: Buyer{
+			Name:     buyerName,
+			Email:    buyerEmail,
+			Tel:      buyerTel,
+			Addr:     buyerAddr,
+			Postcode: buyerPostcode,
+		},
+		Installment: optionalInstallment(cardQuota, interestFreeByMerchant),
+		CustomData:  customData,
+		Notice:      Notice{URL: noticeUrl},
+	})
+}
+
+// SchedulePayment 예약결제
+//
+// Deprecated: Subscribe.Schedule 을 사용하세요.
+func (iamport *Iamport) SchedulePayment(
+	customerUID string, checkingAmount int32,
+	cardNumber, expiry, birth, pwd2Digit, pg string,
+	schedules []*TypeSubscribe.PaymentScheduleParam,
+) ([]*TypeSubscribe.UnitSchedulePaymentResponse, error) {
+	return iamport.Subscribe.Schedule(
+		customerUID, checkingAmount,
+		cardNumber, expiry, birth, pwd2Digit, pg,
+		schedules,
+	)
+}
+
+// UnschedulePayment 예약결제 취소
+//
+// Deprecated: Subscribe.Unschedule 을 사용하세요.
+func (iamport *Iamport) UnschedulePayment(customerUID string, merchantUID []string) ([]*TypeSubscribe.UnitSchedulePaymentResponse, error) {
+	return iamport.Subscribe.Unschedule(customerUID, merchantUID)
+}
+
+// GetScheduledPaymentByMerchantUID Merchant UID별로 예약결제내역을 가져오는 API
+//
+// Deprecated: Subscribe.GetSchedule 을 사용하세요.
+func (iamport *Iamport) GetScheduledPaymentByMerchantUID(merchantUID string) (*TypeSubscribe.UnitSchedulePaymentResponse, error) {
+	return iamport.Subscribe.GetSchedule(merchantUID)
+}
+
+// GetScheduledPaymentByCustomerUID Customer UID별로 예약결제내역을 가져오는 API
+//
+// Deprecated: Subscribe.GetSchedulesByCustomer 를 사용하세요.
+func (iamport *Iamport) GetScheduledPaymentByCustomerUID(
+	customerUID string,
+	page, from, to int32,
+	scheduleStatus util.ScheduleStatus,
+) (*TypeSubscribe.NestedGetPaymentScheduleByCustomerData, error) {
+	return iamport.Subscribe.GetSchedulesByCustomer(customerUID, page, from, to, scheduleStatus)
+}
+
+// GetScheduledPaymentListByCustomerUID Customer UID별로 예약결제내역을 가져오는 API
+// GetScheduledPaymentByCustomerUID 와 같은 내역을 반환한다.
+//
+// Deprecated: Subscribe.GetSchedulesByCustomer 를 사용하세요.
+func (iamport *Iamport) GetScheduledPaymentListByCustomerUID(customerUID string,
+	page, from, to int32, scheduleStatus util.ScheduleStatus,
+) (*TypeSubscribe.NestedGetPaymentScheduleByCustomerData, error) {
+	return iamport.Subscribe.GetSchedulesByCustomer(customerUID, page, from, to, scheduleStatus)
+}
+
+// GetMultipleBillingKeysByCustomer 여러개의 Customer UID를 통하여 빌링키 정보 가져오기
+//
+// Deprecated: Customers.GetMultiple 을 사용하세요.
+func (iamport *Iamport) GetMultipleBillingKeysByCustomer(customerUIDs []string) ([]*TypeSubscribeCust.CustomerBillingKey, error) {
+	return iamport.Customers.GetMultiple(customerUIDs)
+}
+
+// DeleteBillingKey Customer UID에 해당하는 빌링키 삭제
+//
+// Deprecated: Customers.Delete 를 사용하세요.
+func (iamport *Iamport) DeleteBillingKey(customerUID, reason, requester string) (*TypeSubscribeCust.CustomerBillingKey, error) {
+	return iamport.Customers.Delete(customerUID, reason, requester)
+}
+
+// GetBillingKeyByCustomer Customer UID에 해당하는 빌링키 정보 불러오기
+//
+// Deprecated: Customers.Get 을 사용하세요.
+func (iamport *Iamport) GetBillingKeyByCustomer(customerUID string) (*TypeSubscribeCust.CustomerBillingKey, error) {
+	return iamport.Customers.Get(customerUID)
+}
+
+// InsertBillingKeyByCustomer Customer UID에 빌링키 정보 집어넣기
+//
+// Deprecated: Customers.Insert 를 사용하세요.
+func (iamport *Iamport) InsertBillingKeyByCustomer(
+	customerUID, pg string,
+	cardNumber, expiry, birth, pwd2Digit string,
+	customerName, customerTel, customerEmail, customerAddr, customerPostcode string,
+) (*TypeSubscribeCust.CustomerBillingKey, error) {
+	return iamport.Customers.Insert(&BillingKeyRequest{
+		CustomerUID: customerUID,
+		PG:          pg,
+		Card: Card{
+			Number:    cardNumber,
+			Expiry:    expiry,
+			Birth:     birth,
+			Pwd2Digit: pwd2Digit,
+		},
+		Customer: Buyer{
+			Name:     customerName,
+			Tel:      customerTel,
+			Email:    customerEmail,
+			Addr:     customerAddr,
+			Postcode: customerPostcode,
+		},
+	})
+}
+
+// GetPaymentsByCustomer Customer UID로 결제한 내역 불러오기
+//
+// Deprecated: Customers.GetPayments 를 사용하세요.
+func (iamport *Iamport) GetPaymentsByCustomer(customerUID string, page int32) (*TypeSubscribeCust.NestedGetPaidByBillingKeyListData, error) {
+	return iamport.Customers.GetPayments(customerUID, page)
+}
+
+// optionalInt32 예전 함수는 0 인 값을 보내지 않았으므로 0 이 아닐 때만 값을 지정한다.
+func optionalInt32(v int32) *int32 {
+	if v == 0 {
+		return nil
+	}
+
+	return Int32(v)
+}
+
+// optionalInstallment 할부 조건이 모두 기본값이면 지정하지 않는다.
+func optionalInstallment(quota int32, interestFreeByMerchant bool) *Installment {
+	if quota == 0 && !interestFreeByMerchant {
+		return nil
+	}
+
+	return &Installment{Quota: quota, InterestFreeByMerchant: interestFreeByMerchant}
+}
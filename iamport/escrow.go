@@ -0,0 +1,113 @@
+package iamport
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	TypeEscrow "github.com/iamport/interface/gen_src/go/v1/escrow"
+
+	"github.com/iamport/go-iamport/escrow"
+	"github.com/iamport/go-iamport/util"
+)
+
+const (
+	ErrInvalidCourier   = "iamport: courier code is invalid"
+	ErrMustExistInvoice = "iamport: invoice must be exist"
+	ErrMustExistSentAt  = "iamport: sent_at must be exist"
+)
+
+// EscrowService 에스크로 배송정보 API
+type EscrowService service
+
+// EscrowParty 에스크로 배송정보의 발송인, 수취인
+type EscrowParty struct {
+	Name     string
+	Tel      string
+	Addr     string
+	Postcode string
+}
+
+// Logis 에스크로 배송정보
+type Logis struct {
+	Sender   EscrowParty
+	Receiver EscrowParty
+
+	Company escrow.Courier // 택배사 코드
+	Invoice string         // 송장번호
+	SentAt  time.Time      // 발송일시
+}
+
+// RegisterLogis 에스크로 결제건의 배송정보를 등록한다.
+//
+// POST /escrows/logis/{imp_uid}
+func (s *EscrowService) RegisterLogis(impUID string, logis Logis) (*TypeEscrow.Logis, error) {
+	return s.callLogis(impUID, logis, escrow.RegisterLogis)
+}
+
+// UpdateLogis 등록된 에스크로 결제건의 배송정보를 수정한다.
+//
+// PUT /escrows/logis/{imp_uid}
+func (s *EscrowService) UpdateLogis(impUID string, logis Logis) (*TypeEscrow.Logis, error) {
+	return s.callLogis(impUID, logis, escrow.UpdateLogis)
+}
+
+type logisCall func(*http.Client, string, string, *TypeEscrow.EscrowRequest) (*TypeEscrow.EscrowResponse, error)
+
+func (s *EscrowService) callLogis(impUID string, logis Logis, call logisCall) (*TypeEscrow.Logis, error) {
+	if impUID == "" {
+		return nil, errors.New(ErrMustExistImpUID)
+	}
+
+	if !logis.Company.IsValid() {
+		return nil, errors.New(ErrInvalidCourier)
+	}
+
+	if logis.Invoice == "" {
+		return nil, errors.New(ErrMustExistInvoice)
+	}
+
+	if logis.SentAt.IsZero() {
+		return nil, errors.New(ErrMustExistSentAt)
+	}
+
+	token, err := s.iamport.Authenticate.GetToken()
+	if err != nil {
+		return nil, err
+	}
+
+	req := &TypeEscrow.EscrowRequest{
+		ImpUid:   impUID,
+		Sender:   logis.Sender.info(),
+		Receiver: logis.Receiver.info(),
+		Logis: &TypeEscrow.Logis{
+			Company: logis.Company.String(),
+			Invoice: logis.Invoice,
+			SentAt:  int32(logis.SentAt.Unix()),
+		},
+	}
+
+	res, err := call(s.iamport.Authenticate.Client, s.iamport.Authenticate.APIUrl, token, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.Code != util.CodeOK {
+		return nil, errors.New(res.Message)
+	}
+
+	return res.Response, nil
+}
+
+func (p EscrowParty) info() *TypeEscrow.Info {
+	if p == (EscrowParty{}) {
+		return nil
+	}
+
+	return &TypeEscrow.Info{
+		Name:     p.Name,
+		Tel:      p.Tel,
+		Addr:     p.Addr,
+		Postcode: p.Postcode,
+	}
+}
@@ -0,0 +1,81 @@
+package iamport
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	TypeEscrow "github.com/iamport/interface/gen_src/go/v1/escrow"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/iamport/go-iamport/contract"
+	"github.com/iamport/go-iamport/escrow"
+)
+
+var testLogis = Logis{
+	Receiver: EscrowParty{Name: "홍길동", Tel: "010-1234-1234"},
+	Company:  escrow.CourierEPost,
+	Invoice:  "6012345678901",
+	SentAt:   time.Unix(1609459200, 0),
+}
+
+func TestEscrowsRegisterLogis(t *testing.T) {
+	iamport, server := newContractIamport(t, func(w http.ResponseWriter, r *http.Request) {
+		contract.Respond(w, &TypeEscrow.Logis{Company: "EPOST", Invoice: "6012345678901", SentAt: 1609459200, AppliedAt: 1609462800})
+	})
+
+	logis, err := iamport.Escrows.RegisterLogis("imp_1", testLogis)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1609462800), logis.GetAppliedAt())
+
+	req := server.LastRequest()
+	assert.Equal(t, "POST", req.Method)
+	assert.Equal(t, "/escrows/logis/imp_1", req.Path)
+
+	var body map[string]interface{}
+	assert.NoError(t, json.Unmarshal(req.Body, &body))
+	assert.Nil(t, body["sender"])
+	assert.Equal(t, map[string]interface{}{"name": "홍길동", "tel": "010-1234-1234"}, body["receiver"])
+	assert.Equal(t, map[string]interface{}{"company": "EPOST", "invoice": "6012345678901", "sent_at": float64(1609459200)}, body["logis"])
+}
+
+func TestEscrowsUpdateLogis(t *testing.T) {
+	iamport, server := newContractIamport(t, func(w http.ResponseWriter, r *http.Request) {
+		contract.RespondError(w, "에스크로 결제건이 아닙니다.")
+	})
+
+	logis, err := iamport.Escrows.UpdateLogis("imp_1", testLogis)
+	assert.Nil(t, logis)
+	assert.EqualError(t, err, "에스크로 결제건이 아닙니다.")
+	assert.Equal(t, "PUT", server.LastRequest().Method)
+}
+
+func TestEscrowsLogisValidation(t *testing.T) {
+	iamport, server := newContractIamport(t, nil)
+
+	invalid := func(modify func(*Logis)) Logis {
+		logis := testLogis
+		modify(&logis)
+		return logis
+	}
+
+	_, err := iamport.Escrows.RegisterLogis("", testLogis)
+	assert.EqualError(t, err, ErrMustExistImpUID)
+	_, err = iamport.Escrows.RegisterLogis("imp_1", invalid(func(l *Logis) { l.Company = "UNKNOWN" }))
+	assert.EqualError(t, err, ErrInvalidCourier)
+	_, err = iamport.Escrows.RegisterLogis("imp_1", invalid(func(l *Logis) { l.Invoice = "" }))
+	assert.EqualError(t, err, ErrMustExistInvoice)
+	_, err = iamport.Escrows.UpdateLogis("imp_1", invalid(func(l *Logis) { l.SentAt = time.Time{} }))
+	assert.EqualError(t, err, ErrMustExistSentAt)
+
+	// 토큰 발급 외에는 요청하지 않는다.
+	assert.Len(t, server.Requests(), 1)
+}
+
+func TestCourier(t *testing.T) {
+	assert.True(t, escrow.CourierCJ.IsValid())
+	assert.Equal(t, "CJ대한통운", escrow.CourierCJ.Name())
+	assert.False(t, escrow.Courier("UNKNOWN").IsValid())
+	assert.Equal(t, "", escrow.Courier("UNKNOWN").Name())
+}
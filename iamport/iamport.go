@@ -15,6 +15,7 @@ const (
 	ErrMustExistCustomerUID         = "iamport: customer_uid must be exist"
 	ErrInvalidStatusParam           = "iamport: status parmeter is invalid. must be all, ready, paid, failed and cancelled"
 	ErrInvalidSortParam             = "iamport: sort parmeter is invalid. must be -started, started, -paid, paid, -updated and updated"
+	ErrInvalidScheduleStatusParam   = "iamport: schedule status parmeter is invalid. must be scheduled, executed and revoked"
 	ErrInvalidPage                  = "iamport: page is more than 1"
 	ErrInvalidLimit                 = "iamport: limit is more than 0"
 	ErrInvalidFrom                  = "iamport: 'from' cannot be more future than 'to'"
@@ -22,8 +23,24 @@ const (
 	ErrInvalidAmount                = "iamport: amount is more than 0"
 )
 
+// Iamport 아임포트 REST API 클라이언트
+// API 영역별 기능은 Payments, Subscribe, Customers, Escrows, Vbanks, Certifications, Receipts 서비스로 나뉘어 있으며
+// 모든 서비스는 Iamport의 http.Client와 인증 정보를 공유한다.
 type Iamport struct {
 	Authenticate *authenticate.Authenticate
+
+	Payments       *PaymentService
+	Subscribe      *SubscribeService
+	Customers      *CustomerService
+	Escrows        *EscrowService
+	Vbanks         *VbankService
+	Certifications *CertificationService
+	Receipts       *ReceiptService
+}
+
+// service 서비스들이 공유하는 Iamport
+type service struct {
+	iamport *Iamport
 }
 
 func NewIamport(apiURL string, restAPIKey string, restAPISecret string) (*Iamport, error) {
@@ -34,9 +51,23 @@ func NewIamport(apiURL string, restAPIKey string, restAPISecret string) (*Iampor
 		return nil, err
 	}
 
+	return NewIamportWithAuthenticate(auth), nil
+}
+
+// NewIamportWithAuthenticate 이미 발급받은 authenticate 모듈로 Iamport를 만든다.
+func NewIamportWithAuthenticate(auth *authenticate.Authenticate) *Iamport {
 	iamport := &Iamport{
 		Authenticate: auth,
 	}
 
-	return iamport, nil
+	common := service{iamport: iamport}
+	iamport.Payments = (*PaymentService)(&common)
+	iamport.Subscribe = (*SubscribeService)(&common)
+	iamport.Customers = (*CustomerService)(&common)
+	iamport.Escrows = (*EscrowService)(&common)
+	iamport.Vbanks = (*VbankService)(&common)
+	iamport.Certifications = (*CertificationService)(&common)
+	iamport.Receipts = (*ReceiptService)(&common)
+
+	return iamport
 }
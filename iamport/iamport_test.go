@@ -0,0 +1,99 @@
+package iamport
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/iamport/go-iamport/contract"
+	"github.com/iamport/go-iamport/util"
+)
+
+func newContractIamport(t *testing.T, handler http.HandlerFunc) (*Iamport, *contract.Server) {
+	server := contract.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	auth, err := server.Authenticate()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return NewIamportWithAuthenticate(auth), server
+}
+
+func TestServicesShareAuthenticate(t *testing.T) {
+	iamport, server := newContractIamport(t, nil)
+
+	_, err := iamport.Payments.Get("imp_785510843101")
+	assert.NoError(t, err)
+	_, err = iamport.Subscribe.GetSchedule("merchant_1")
+	assert.NoError(t, err)
+	_, err = iamport.Customers.Get("customer_1")
+	assert.NoError(t, err)
+
+	// 토큰 발급은 NewAuthenticate 에서 한 번만 일어난다.
+	requests := server.Requests()
+	assert.Len(t, requests, 4)
+	for _, req := range requests[1:] {
+		assert.Equal(t, contract.Token, req.Header.Get("Authorization"))
+	}
+}
+
+func TestDeprecatedMethodsUseServices(t *testing.T) {
+	iamport, server := newContractIamport(t, nil)
+
+	_, err := iamport.GetPaymentImpUID("imp_785510843101")
+	assert.NoError(t, err)
+	deprecated := server.LastRequest()
+
+	_, err = iamport.Payments.Get("imp_785510843101")
+	assert.NoError(t, err)
+	assert.Equal(t, deprecated.Golden(), server.LastRequest().Golden())
+}
+
+func TestScheduledPaymentListByCustomerUIDUsesCanonicalEndpoint(t *testing.T) {
+	iamport, server := newContractIamport(t, nil)
+
+	_, err := iamport.GetScheduledPaymentListByCustomerUID("customer_1", 0, 1, 2, "scheduled")
+	assert.NoError(t, err)
+	assert.Equal(t, "/subscribe/payments/schedule/customers/customer_1", server.LastRequest().Path)
+	assert.Equal(t, "page=1&from=1&to=2&schedule-status=scheduled", server.LastRequest().RawQuery)
+}
+
+func TestPaymentsGetMultipleWithoutImpUIDs(t *testing.T) {
+	iamport, server := newContractIamport(t, nil)
+
+	payments, err := iamport.Payments.GetMultiple(nil)
+	assert.EqualError(t, err, ErrMustExistImpUID)
+	assert.Nil(t, payments)
+	assert.Len(t, server.Requests(), 1)
+}
+
+func TestPaymentsFindByMerchantUIDInvalidStatus(t *testing.T) {
+	iamport, _ := newContractIamport(t, nil)
+
+	payment, err := iamport.Payments.FindByMerchantUID("ORD20180131-0009728", "error", "")
+	assert.EqualError(t, err, ErrInvalidStatusParam)
+	assert.Nil(t, payment)
+}
+
+func TestPaymentsListByCancelledStatus(t *testing.T) {
+	iamport, server := newContractIamport(t, nil)
+
+	from := time.Date(2020, 9, 1, 0, 0, 0, 0, time.UTC)
+	_, err := iamport.Payments.ListByStatus(util.StatusCancelled, 1, 20, from, from.AddDate(0, 0, 1), util.SortDESCUpdated)
+	assert.NoError(t, err)
+	assert.Equal(t, "/payments/status/cancelled", server.LastRequest().Path)
+	assert.Contains(t, server.LastRequest().RawQuery, "sorting=-updated")
+}
+
+func TestSubscribeGetSchedulesByCustomerInvalidStatus(t *testing.T) {
+	iamport, server := newContractIamport(t, nil)
+
+	schedules, err := iamport.Subscribe.GetSchedulesByCustomer("customer_1", 1, 0, 0, "paid")
+	assert.EqualError(t, err, ErrInvalidScheduleStatusParam)
+	assert.Nil(t, schedules)
+	assert.Len(t, server.Requests(), 1)
+}
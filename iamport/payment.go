@@ -1,6 +1,7 @@
 package iamport
 
 import (
+	"context"
 	"errors"
 	"time"
 
@@ -9,15 +10,18 @@ import (
 	TypePayment "github.com/iamport/interface/gen_src/go/v1/payment"
 )
 
-// GetPaymentImpUID imp_uid로 결제 정보 가져오기
+// PaymentService 결제 조회, 취소, 사전등록 API
+type PaymentService service
+
+// Get imp_uid로 결제 정보 가져오기
 //
 // GET /payments/{imp_uid}
-func (iamport *Iamport) GetPaymentImpUID(iuid string) (*TypePayment.Payment, error) {
+func (s *PaymentService) Get(iuid string) (*TypePayment.Payment, error) {
 	if iuid == "" {
 		return nil, errors.New(ErrMustExistImpUID)
 	}
 
-	token, err := iamport.Authenticate.GetToken()
+	token, err := s.iamport.Authenticate.GetToken()
 	if err != nil {
 		return nil, err
 	}
@@ -27,7 +31,7 @@ func (iamport *Iamport) GetPaymentImpUID(iuid string) (*TypePayment.Payment, err
 	}
 
 	res, err := payment.GetByImpUID(
-		iamport.Authenticate.Client, iamport.Authenticate.APIUrl,
+		s.iamport.Authenticate.Client, s.iamport.Authenticate.APIUrl,
 		token, reqPaymentImpUID,
 	)
 	if err != nil {
@@ -41,15 +45,24 @@ func (iamport *Iamport) GetPaymentImpUID(iuid string) (*TypePayment.Payment, err
 	return res.Response, nil
 }
 
-// GetPaymentsImpUIDs 여러개의 imp_uid로 결제 정보 가져오기
+// GetMultiple 여러개의 imp_uid로 결제 정보 가져오기
 //
 // GET /payments
-func (iamport *Iamport) GetPaymentsImpUIDs(iuids []string) ([]*TypePayment.Payment, error) {
-	if len(iuids) < 0 {
+func (s *PaymentService) GetMultiple(iuids []string) ([]*TypePayment.Payment, error) {
+	if len(iuids) == 0 {
 		return nil, errors.New(ErrMustExistImpUID)
 	}
 
-	token, err := iamport.Authenticate.GetToken()
+	if len(iuids) > payment.MaxImpUIDs {
+		res, err := s.GetBatch(context.Background(), iuids, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		return res.Payments, nil
+	}
+
+	token, err := s.iamport.Authenticate.GetToken()
 	if err != nil {
 		return nil, err
 	}
@@ -59,7 +72,7 @@ func (iamport *Iamport) GetPaymentsImpUIDs(iuids []string) ([]*TypePayment.Payme
 	}
 
 	res, err := payment.GetByImpUIDs(
-		iamport.Authenticate.Client, iamport.Authenticate.APIUrl,
+		s.iamport.Authenticate.Client, s.iamport.Authenticate.APIUrl,
 		token, req,
 	)
 	if err != nil {
@@ -73,35 +86,35 @@ func (iamport *Iamport) GetPaymentsImpUIDs(iuids []string) ([]*TypePayment.Payme
 	return res.Response, nil
 }
 
-// GetPaymentMerchantUID merchant_uid로 결제 정보 가져오기
+// FindByMerchantUID merchant_uid로 결제 정보 가져오기
 //
 // GET /payments/find/{merchant_uid}
-func (iamport *Iamport) GetPaymentMerchantUID(muid string, status string, sorting string) (*TypePayment.Payment, error) {
+func (s *PaymentService) FindByMerchantUID(muid string, status util.PaymentStatus, sorting util.Sort) (*TypePayment.Payment, error) {
 	if muid == "" {
 		return nil, errors.New(ErrMustExistMerchantUID)
 	}
 
-	if !util.ValidateStatusParameter(status) {
-		return nil, errors.New(ErrInvalidSortParam)
+	if !util.ValidateStatusParameter(status.String()) {
+		return nil, errors.New(ErrInvalidStatusParam)
 	}
 
-	if !util.ValidateSortParameter(sorting) {
+	if !util.ValidateSortParameter(sorting.String()) {
 		return nil, errors.New(ErrInvalidSortParam)
 	}
 
-	token, err := iamport.Authenticate.GetToken()
+	token, err := s.iamport.Authenticate.GetToken()
 	if err != nil {
 		return nil, err
 	}
 
 	merchantUIDPaymentReq := &TypePayment.PaymentMerchantUidRequest{
 		MerchantUid: muid,
-		Status:      status,
-		Sorting:     sorting,
+		Status:      status.String(),
+		Sorting:     sorting.String(),
 	}
 
 	res, err := payment.GetByMerchantUID(
-		iamport.Authenticate.Client, iamport.Authenticate.APIUrl,
+		s.iamport.Authenticate.Client, s.iamport.Authenticate.APIUrl,
 		token, merchantUIDPaymentReq,
 	)
 
@@ -116,19 +129,19 @@ func (iamport *Iamport) GetPaymentMerchantUID(muid string, status string, sortin
 	return res.Response, nil
 }
 
-// GetPaymentsMerchantUID merchant_uid로 모든 결제 정보 가져오기
+// FindAllByMerchantUID merchant_uid로 모든 결제 정보 가져오기
 //
-// GET /payments/find/{merchant_uid}
-func (iamport *Iamport) GetPaymentsMerchantUID(muid string, status string, sorting string, page int) (*TypePayment.PaymentPage, error) {
+// GET /payments/findAll/{merchant_uid}
+func (s *PaymentService) FindAllByMerchantUID(muid string, status util.PaymentStatus, sorting util.Sort, page int) (*TypePayment.PaymentPage, error) {
 	if muid == "" {
 		return nil, errors.New(ErrMustExistMerchantUID)
 	}
 
-	if !util.ValidateStatusParameter(status) {
-		return nil, errors.New(ErrInvalidSortParam)
+	if !util.ValidateStatusParameter(status.String()) {
+		return nil, errors.New(ErrInvalidStatusParam)
 	}
 
-	if !util.ValidateSortParameter(sorting) {
+	if !util.ValidateSortParameter(sorting.String()) {
 		return nil, errors.New(ErrInvalidSortParam)
 	}
 
@@ -136,20 +149,20 @@ func (iamport *Iamport) GetPaymentsMerchantUID(muid string, status string, sorti
 		return nil, errors.New(ErrInvalidPage)
 	}
 
-	token, err := iamport.Authenticate.GetToken()
+	token, err := s.iamport.Authenticate.GetToken()
 	if err != nil {
 		return nil, err
 	}
 
 	merchantUIDPaymentReq := &TypePayment.PaymentsMerchantUidRequest{
 		MerchantUid: muid,
-		Status:      status,
-		Sorting:     sorting,
+		Status:      status.String(),
+		Sorting:     sorting.String(),
 		Page:        int32(page),
 	}
 
 	res, err := payment.GetByMerchantUIDs(
-		iamport.Authenticate.Client, iamport.Authenticate.APIUrl,
+		s.iamport.Authenticate.Client, s.iamport.Authenticate.APIUrl,
 		token, merchantUIDPaymentReq,
 	)
 	if err != nil {
@@ -163,15 +176,15 @@ func (iamport *Iamport) GetPaymentsMerchantUID(muid string, status string, sorti
 	return res.Response, nil
 }
 
-// GetPaymentsStatus 결제 상태에 따른 결제 정보들 가져오기
+// ListByStatus 결제 상태에 따른 결제 정보들 가져오기
 //
 // GET /payments/status/{payment_status}
-func (iamport *Iamport) GetPaymentsStatus(status string, page int, limit int, from time.Time, to time.Time, sorting string) (*TypePayment.PaymentPage, error) {
-	if !util.ValidateSortParameter(sorting) {
+func (s *PaymentService) ListByStatus(status util.PaymentStatus, page int, limit int, from time.Time, to time.Time, sorting util.Sort) (*TypePayment.PaymentPage, error) {
+	if !util.ValidateSortParameter(sorting.String()) {
 		return nil, errors.New(ErrInvalidSortParam)
 	}
 
-	if !util.ValidateStatusParameter(status) {
+	if !util.ValidateStatusParameter(status.String()) {
 		return nil, errors.New(ErrInvalidStatusParam)
 	}
 
@@ -187,27 +200,26 @@ func (iamport *Iamport) GetPaymentsStatus(status string, page int, limit int, fr
 		return nil, errors.New(ErrInvalidFrom)
 	}
 
-	if from.AddDate(0, 3, 0).Before(to) {
+	if addListMonths(from, MaxListMonths).Before(to) {
 		return nil, errors.New(ErrInvalidTo)
 	}
 
-	token, err := iamport.Authenticate.GetToken()
+	token, err := s.iamport.Authenticate.GetToken()
 	if err != nil {
 		return nil, err
 	}
 
 	req := &TypePayment.PaymentStatusRequest{
-
-		Status:  status,
+		Status:  status.String(),
 		Page:    int32(page),
 		From:    int32(from.Unix()),
 		Limit:   int32(limit),
-		Sorting: sorting,
+		Sorting: sorting.String(),
 		To:      int32(to.Unix()),
 	}
 
 	res, err := payment.GetByStatus(
-		iamport.Authenticate.Client, iamport.Authenticate.APIUrl,
+		s.iamport.Authenticate.Client, s.iamport.Authenticate.APIUrl,
 		token, req,
 	)
 
@@ -222,15 +234,15 @@ func (iamport *Iamport) GetPaymentsStatus(status string, page int, limit int, fr
 	return res.Response, nil
 }
 
-// GetPaymentBalanceImpUID imp_uid로 결제 정보 가져오기
+// GetBalance imp_uid로 결제수단별 금액 상세정보 가져오기
 //
-// GET /payments/{imp_uid}
-func (iamport *Iamport) GetPaymentBalanceImpUID(iuid string) (*TypePayment.PaymentBalance, error) {
+// GET /payments/{imp_uid}/balance
+func (s *PaymentService) GetBalance(iuid string) (*TypePayment.PaymentBalance, error) {
 	if iuid == "" {
 		return nil, errors.New(ErrMustExistImpUID)
 	}
 
-	token, err := iamport.Authenticate.GetToken()
+	token, err := s.iamport.Authenticate.GetToken()
 	if err != nil {
 		return nil, err
 	}
@@ -240,7 +252,7 @@ func (iamport *Iamport) GetPaymentBalanceImpUID(iuid string) (*TypePayment.Payme
 	}
 
 	res, err := payment.GetBalanceByImpUID(
-		iamport.Authenticate.Client, iamport.Authenticate.APIUrl,
+		s.iamport.Authenticate.Client, s.iamport.Authenticate.APIUrl,
 		token, reqPaymentImpUID,
 	)
 	if err != nil {
@@ -254,10 +266,10 @@ func (iamport *Iamport) GetPaymentBalanceImpUID(iuid string) (*TypePayment.Payme
 	return res.Response, nil
 }
 
-// CancelPaymentImpUID imp_uid로 결제 취소하기
+// Cancel imp_uid로 결제 취소하기
 //
 // POST /payments/cancel
-func (iamport *Iamport) CancelPaymentImpUID(iuid string, merchantUID string, amount float64, taxFree float64, checkSum float64, reason string, refundHolder string, refundBank string, refundAccount string) (*TypePayment.Payment, error) {
+func (s *PaymentService) Cancel(iuid string, merchantUID string, amount float64, taxFree float64, checkSum float64, reason string, refundHolder string, refundBank string, refundAccount string) (*TypePayment.Payment, error) {
 	if iuid == "" && merchantUID == "" {
 		return nil, errors.New(ErrMustExistImpUIDorMerchantUID)
 	}
@@ -266,7 +278,7 @@ func (iamport *Iamport) CancelPaymentImpUID(iuid string, merchantUID string, amo
 		return nil, errors.New(ErrInvalidAmount)
 	}
 
-	token, err := iamport.Authenticate.GetToken()
+	token, err := s.iamport.Authenticate.GetToken()
 	if err != nil {
 		return nil, err
 	}
@@ -284,7 +296,7 @@ func (iamport *Iamport) CancelPaymentImpUID(iuid string, merchantUID string, amo
 	}
 
 	res, err := payment.Cancel(
-		iamport.Authenticate.Client, iamport.Authenticate.APIUrl,
+		s.iamport.Authenticate.Client, s.iamport.Authenticate.APIUrl,
 		token, req,
 	)
 	if err != nil {
@@ -298,10 +310,10 @@ func (iamport *Iamport) CancelPaymentImpUID(iuid string, merchantUID string, amo
 	return res.Response, nil
 }
 
-// PreparePayment 결제 정보 사전 등록하기
+// Prepare 결제 정보 사전 등록하기
 //
 // POST /payments/prepare
-func (iamport *Iamport) PreparePayment(merchantUID string, amount float64) (*TypePayment.Prepare, error) {
+func (s *PaymentService) Prepare(merchantUID string, amount float64) (*TypePayment.Prepare, error) {
 	if merchantUID == "" {
 		return nil, errors.New(ErrMustExistMerchantUID)
 	}
@@ -310,7 +322,7 @@ func (iamport *Iamport) PreparePayment(merchantUID string, amount float64) (*Typ
 		return nil, errors.New(ErrInvalidAmount)
 	}
 
-	token, err := iamport.Authenticate.GetToken()
+	token, err := s.iamport.Authenticate.GetToken()
 	if err != nil {
 		return nil, err
 	}
@@ -321,7 +333,7 @@ func (iamport *Iamport) PreparePayment(merchantUID string, amount float64) (*Typ
 	}
 
 	res, err := payment.Prepare(
-		iamport.Authenticate.Client, iamport.Authenticate.APIUrl,
+		s.iamport.Authenticate.Client, s.iamport.Authenticate.APIUrl,
 		token, req,
 	)
 	if err != nil {
@@ -335,15 +347,15 @@ func (iamport *Iamport) PreparePayment(merchantUID string, amount float64) (*Typ
 	return res.Response, nil
 }
 
-// GetPreparePayment 사전 등록된 결제 정보 보기
+// GetPrepared 사전 등록된 결제 정보 보기
 //
 // GET /payments/prepare/{merchant_uid}
-func (iamport *Iamport) GetPreparePayment(merchantUID string) (*TypePayment.Prepare, error) {
+func (s *PaymentService) GetPrepared(merchantUID string) (*TypePayment.Prepare, error) {
 	if merchantUID == "" {
 		return nil, errors.New(ErrMustExistMerchantUID)
 	}
 
-	token, err := iamport.Authenticate.GetToken()
+	token, err := s.iamport.Authenticate.GetToken()
 	if err != nil {
 		return nil, err
 	}
@@ -353,7 +365,7 @@ func (iamport *Iamport) GetPreparePayment(merchantUID string) (*TypePayment.Prep
 	}
 
 	res, err := payment.GetPrepareByMerchantUID(
-		iamport.Authenticate.Client, iamport.Authenticate.APIUrl,
+		s.iamport.Authenticate.Client, s.iamport.Authenticate.APIUrl,
 		token, req,
 	)
 	if err != nil {
@@ -0,0 +1,193 @@
+package iamport
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	TypePayment "github.com/iamport/interface/gen_src/go/v1/payment"
+
+	"github.com/iamport/go-iamport/payment"
+	"github.com/iamport/go-iamport/util"
+)
+
+// BatchOptions 여러 건 조회 조건
+type BatchOptions struct {
+	ChunkSize   int // 한 번에 요청할 imp_uid 개수, 0이거나 100을 넘으면 100
+	Concurrency int // 동시에 보낼 요청 수, 0 또는 1이면 순서대로 요청한다.
+
+	// Limiter 가 있으면 매 요청 전에 Wait 을 호출한다.
+	Limiter Limiter
+}
+
+// BatchResult 여러 건 조회 결과
+type BatchResult struct {
+	Payments []*TypePayment.Payment // 조회된 결제건 (입력 순서)
+	NotFound []string               // 조회되지 않은 imp_uid (입력 순서)
+	Failed   []string               // 요청이 실패하여 확인하지 못한 imp_uid (입력 순서)
+}
+
+// ChunkError 나누어 보낸 요청 하나의 오류
+type ChunkError struct {
+	Index   int      // 몇 번째 요청인지 (0부터)
+	ImpUIDs []string // 해당 요청에 포함된 imp_uid
+	Err     error
+}
+
+func (e *ChunkError) Error() string {
+	return fmt.Sprintf("iamport: chunk %d (%d imp_uids): %s", e.Index, len(e.ImpUIDs), e.Err)
+}
+
+func (e *ChunkError) Unwrap() error {
+	return e.Err
+}
+
+// BatchError 실패한 요청들의 오류 목록
+type BatchError struct {
+	Chunks []*ChunkError
+}
+
+func (e *BatchError) Error() string {
+	messages := make([]string, len(e.Chunks))
+	for i, chunk := range e.Chunks {
+		messages[i] = chunk.Error()
+	}
+
+	return strings.Join(messages, "; ")
+}
+
+// GetBatch 개수 제한 없이 여러 imp_uid 의 결제 정보를 가져온다.
+//
+// imp_uid 를 ChunkSize 개씩 나누어 요청하고, 실패한 요청이 있더라도 나머지 결과는 채워서 return 해준다.
+// 이 경우 error 는 *BatchError 이며 실패한 요청의 imp_uid 는 BatchResult.Failed 에 담긴다.
+func (s *PaymentService) GetBatch(ctx context.Context, impUIDs []string, opts *BatchOptions) (*BatchResult, error) {
+	if len(impUIDs) == 0 {
+		return nil, errors.New(ErrMustExistImpUID)
+	}
+
+	if opts == nil {
+		opts = &BatchOptions{}
+	}
+
+	chunks := chunkStrings(impUIDs, opts.ChunkSize)
+	found := make([][]*TypePayment.Payment, len(chunks))
+	chunkErrs := make([]*ChunkError, len(chunks))
+
+	concurrency := opts.Concurrency
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	var (
+		wg  sync.WaitGroup
+		sem = make(chan struct{}, concurrency)
+	)
+
+	for i, chunk := range chunks {
+		sem <- struct{}{}
+		wg.Add(1)
+		go func(i int, chunk []string) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			payments, err := s.getChunk(ctx, chunk, opts.Limiter)
+			if err != nil {
+				chunkErrs[i] = &ChunkError{Index: i, ImpUIDs: chunk, Err: err}
+				return
+			}
+
+			found[i] = payments
+		}(i, chunk)
+	}
+	wg.Wait()
+
+	byImpUID := map[string]*TypePayment.Payment{}
+	failed := map[string]bool{}
+	batchErr := &BatchError{}
+	for i := range chunks {
+		if chunkErrs[i] != nil {
+			batchErr.Chunks = append(batchErr.Chunks, chunkErrs[i])
+			for _, impUID := range chunkErrs[i].ImpUIDs {
+				failed[impUID] = true
+			}
+			continue
+		}
+
+		for _, pay := range found[i] {
+			byImpUID[pay.GetImpUid()] = pay
+		}
+	}
+
+	result := &BatchResult{}
+	for _, impUID := range impUIDs {
+		switch {
+		case byImpUID[impUID] != nil:
+			result.Payments = append(result.Payments, byImpUID[impUID])
+		case failed[impUID]:
+			result.Failed = append(result.Failed, impUID)
+		default:
+			result.NotFound = append(result.NotFound, impUID)
+		}
+	}
+
+	if len(batchErr.Chunks) > 0 {
+		return result, batchErr
+	}
+
+	return result, nil
+}
+
+func (s *PaymentService) getChunk(ctx context.Context, impUIDs []string, limiter Limiter) ([]*TypePayment.Payment, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	if limiter != nil {
+		if err := limiter.Wait(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	token, err := s.iamport.Authenticate.GetToken()
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := payment.GetByImpUIDs(
+		s.iamport.Authenticate.Client, s.iamport.Authenticate.APIUrl,
+		token, &TypePayment.PaymentsRequest{ImpUid: impUIDs},
+	)
+	if err != nil {
+		// 요청한 imp_uid 가 하나도 없으면 404 로 응답한다.
+		if err.Error() == util.ErrStatusNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	if res.Code != util.CodeOK {
+		return nil, errors.New(res.Message)
+	}
+
+	return res.Response, nil
+}
+
+// chunkStrings src 를 size 개씩 나눈다. size 가 0이거나 MaxImpUIDs 를 넘으면 MaxImpUIDs 개씩 나눈다.
+func chunkStrings(src []string, size int) [][]string {
+	if size <= 0 || size > payment.MaxImpUIDs {
+		size = payment.MaxImpUIDs
+	}
+
+	chunks := make([][]string, 0, (len(src)+size-1)/size)
+	for start := 0; start < len(src); start += size {
+		end := start + size
+		if end > len(src) {
+			end = len(src)
+		}
+		chunks = append(chunks, src[start:end])
+	}
+
+	return chunks
+}
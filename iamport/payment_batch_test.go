@@ -0,0 +1,120 @@
+package iamport
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	TypePayment "github.com/iamport/interface/gen_src/go/v1/payment"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/iamport/go-iamport/contract"
+	"github.com/iamport/go-iamport/util"
+)
+
+// batchPayments imp_uid[] 중 missing_ 으로 시작하지 않는 결제건을 순서를 뒤집어 응답한다.
+// broken_ 이 포함된 요청은 500, 모두 missing_ 이면 404 로 응답한다.
+func batchPayments(requests *int32) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(requests, 1)
+
+		impUIDs := r.URL.Query()["imp_uid[]"]
+		var list []*TypePayment.Payment
+		for i := len(impUIDs) - 1; i >= 0; i-- {
+			if strings.HasPrefix(impUIDs[i], "broken_") {
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+
+			if !strings.HasPrefix(impUIDs[i], "missing_") {
+				list = append(list, &TypePayment.Payment{ImpUid: impUIDs[i]})
+			}
+		}
+
+		if len(list) == 0 {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		contract.Respond(w, list)
+	}
+}
+
+func impUIDs(prefix string, n int) []string {
+	uids := make([]string, n)
+	for i := range uids {
+		uids[i] = fmt.Sprintf("%s%03d", prefix, i)
+	}
+
+	return uids
+}
+
+func TestPaymentsGetBatchChunksAndPreservesOrder(t *testing.T) {
+	var requests int32
+	iamport, _ := newContractIamport(t, batchPayments(&requests))
+
+	uids := impUIDs("imp_", 250)
+	uids[10] = "missing_1"
+	uids[200] = "missing_2"
+
+	res, err := iamport.Payments.GetBatch(context.Background(), uids, &BatchOptions{Concurrency: 3})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(3), atomic.LoadInt32(&requests))
+
+	assert.Len(t, res.Payments, 248)
+	assert.Equal(t, "imp_000", res.Payments[0].GetImpUid())
+	assert.Equal(t, "imp_011", res.Payments[10].GetImpUid())
+	assert.Equal(t, "imp_249", res.Payments[247].GetImpUid())
+	assert.Equal(t, []string{"missing_1", "missing_2"}, res.NotFound)
+	assert.Empty(t, res.Failed)
+}
+
+func TestPaymentsGetBatchPartialFailure(t *testing.T) {
+	var requests int32
+	iamport, _ := newContractIamport(t, batchPayments(&requests))
+
+	uids := append(impUIDs("imp_", 2), "broken_0", "imp_100")
+	uids = append(uids, impUIDs("missing_", 2)...)
+
+	res, err := iamport.Payments.GetBatch(context.Background(), uids, &BatchOptions{ChunkSize: 2})
+	assert.Equal(t, int32(3), atomic.LoadInt32(&requests))
+
+	var batchErr *BatchError
+	assert.True(t, errors.As(err, &batchErr))
+	assert.Len(t, batchErr.Chunks, 1)
+	assert.Equal(t, 1, batchErr.Chunks[0].Index)
+	assert.Equal(t, []string{"broken_0", "imp_100"}, batchErr.Chunks[0].ImpUIDs)
+	assert.EqualError(t, errors.Unwrap(batchErr.Chunks[0]), util.ErrUnknown)
+
+	// 실패한 요청과 관계없는 결과는 그대로 채워진다.
+	assert.Len(t, res.Payments, 2)
+	assert.Equal(t, []string{"broken_0", "imp_100"}, res.Failed)
+	assert.Equal(t, []string{"missing_000", "missing_001"}, res.NotFound)
+}
+
+func TestPaymentsGetBatchCancelledContext(t *testing.T) {
+	var requests int32
+	iamport, _ := newContractIamport(t, batchPayments(&requests))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	res, err := iamport.Payments.GetBatch(ctx, impUIDs("imp_", 150), nil)
+	assert.Error(t, err)
+	assert.Len(t, res.Failed, 150)
+	assert.Equal(t, int32(0), atomic.LoadInt32(&requests))
+}
+
+func TestPaymentsGetMultipleOverLimit(t *testing.T) {
+	var requests int32
+	iamport, _ := newContractIamport(t, batchPayments(&requests))
+
+	payments, err := iamport.Payments.GetMultiple(impUIDs("imp_", 101))
+	assert.NoError(t, err)
+	assert.Len(t, payments, 101)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&requests))
+}
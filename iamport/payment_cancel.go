@@ -0,0 +1,107 @@
+package iamport
+
+import (
+	"errors"
+	"fmt"
+
+	TypePayment "github.com/iamport/interface/gen_src/go/v1/payment"
+
+	"github.com/iamport/go-iamport/refund"
+)
+
+const (
+	ErrCancelAmountExceeded = "iamport: cancel amount is more than cancellable amount"
+	ErrInvalidTaxFree       = "iamport: tax_free must be between 0 and cancel amount"
+)
+
+// RefundAccount 가상계좌 결제 환불 계좌
+type RefundAccount struct {
+	Holder  string // 예금주
+	Bank    string // 은행 코드
+	Account string // 계좌번호
+}
+
+// PartialCancelRequest 부분 취소 요청
+type PartialCancelRequest struct {
+	Amount  int32 // 취소할 금액
+	TaxFree int32 // 취소할 금액 중 면세공급가액
+	Reason  string
+
+	// ExpectedRemaining 취소를 결정할 때 확인한 취소 가능 잔액.
+	// 지정하면 결제 정보의 잔액과 다를 때 취소 요청을 보내지 않고 *BalanceChangedError 를 return 해준다.
+	ExpectedRemaining *int32
+
+	// Refund 가상계좌 결제건의 환불 계좌
+	Refund RefundAccount
+}
+
+// BalanceChangedError 취소 가능 잔액이 확인한 시점과 달라진 경우 (다른 취소 요청과 동시에 처리된 경우 등)
+type BalanceChangedError struct {
+	ImpUID    string
+	Expected  int32 // 취소 요청 시 기대한 잔액
+	Remaining int32 // 현재 잔액
+}
+
+func (e *BalanceChangedError) Error() string {
+	return fmt.Sprintf("iamport: cancellable amount of %s changed: expected %d, remaining %d", e.ImpUID, e.Expected, e.Remaining)
+}
+
+// Cancellable 결제건의 취소 가능 잔액
+// cancel_amount 와 cancel_history 합계 중 큰 값을 이미 취소된 금액으로 본다.
+//
+// 아임포트 결제 정보에는 면세공급가액이 포함되어 있지 않으므로 면세공급가액 잔액은 계산하지 않는다.
+// 취소 내역과 부가세 구성은 refund 패키지를 참고한다.
+func Cancellable(pay *TypePayment.Payment) int32 {
+	return refund.Summarize(pay).Refundable
+}
+
+// PartialCancel 결제 정보를 가져와 취소 가능 잔액을 확인한 뒤, 잔액을 checksum 으로 보내 부분 취소한다.
+//
+// 다른 취소 요청이 먼저 처리되어 잔액이 달라지면 아임포트가 취소를 거절하며, 이 경우 *BalanceChangedError 를 return 해준다.
+//
+// GET /payments/{imp_uid}, POST /payments/cancel
+func (s *PaymentService) PartialCancel(impUID string, req PartialCancelRequest) (*TypePayment.Payment, error) {
+	if impUID == "" {
+		return nil, errors.New(ErrMustExistImpUID)
+	}
+
+	if req.Amount <= 0 {
+		return nil, errors.New(ErrInvalidAmount)
+	}
+
+	if req.TaxFree < 0 || req.TaxFree > req.Amount {
+		return nil, errors.New(ErrInvalidTaxFree)
+	}
+
+	pay, err := s.Get(impUID)
+	if err != nil {
+		return nil, err
+	}
+
+	remaining := Cancellable(pay)
+	if req.ExpectedRemaining != nil && *req.ExpectedRemaining != remaining {
+		return nil, &BalanceChangedError{ImpUID: impUID, Expected: *req.ExpectedRemaining, Remaining: remaining}
+	}
+
+	if req.Amount > remaining {
+		return nil, errors.New(ErrCancelAmountExceeded)
+	}
+
+	cancelled, err := s.Cancel(
+		impUID, "",
+		float64(req.Amount), float64(req.TaxFree), float64(remaining),
+		req.Reason,
+		req.Refund.Holder, req.Refund.Bank, req.Refund.Account,
+	)
+	if err == nil {
+		return cancelled, nil
+	}
+
+	// checksum 불일치로 거절되었는지 확인하기 위해 잔액을 다시 확인한다.
+	current, getErr := s.Get(impUID)
+	if getErr == nil && Cancellable(current) != remaining {
+		return nil, &BalanceChangedError{ImpUID: impUID, Expected: remaining, Remaining: Cancellable(current)}
+	}
+
+	return nil, err
+}
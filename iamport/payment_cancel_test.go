@@ -0,0 +1,141 @@
+package iamport
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"sync"
+	"testing"
+
+	TypePayment "github.com/iamport/interface/gen_src/go/v1/payment"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/iamport/go-iamport/contract"
+	"github.com/iamport/go-iamport/util"
+)
+
+// cancelServer 결제금액 1000원의 결제건을 흉내낸다. checksum 이 잔액과 다르면 취소를 거절한다.
+// beforeCancel 은 취소 요청을 처리하기 직전에 호출된다. (동시 취소 재현)
+type cancelServer struct {
+	mu           sync.Mutex
+	pay          *TypePayment.Payment
+	beforeCancel func(pay *TypePayment.Payment)
+}
+
+func newCancelServer() *cancelServer {
+	return &cancelServer{pay: &TypePayment.Payment{
+		ImpUid: "imp_1",
+		Amount: 1000,
+		Status: string(util.StatusPaid),
+	}}
+}
+
+func (s *cancelServer) handle(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if r.URL.Path != "/payments/cancel" {
+		contract.Respond(w, s.pay)
+		return
+	}
+
+	if s.beforeCancel != nil {
+		s.beforeCancel(s.pay)
+		s.beforeCancel = nil
+	}
+
+	amount, _ := strconv.Atoi(r.FormValue("amount"))
+	checksum, _ := strconv.Atoi(r.FormValue("checksum"))
+	if int32(checksum) != s.pay.Amount-s.pay.CancelAmount {
+		contract.RespondError(w, "취소 가능 잔액 검증에 실패하였습니다.")
+		return
+	}
+
+	cancel(s.pay, int32(amount))
+	contract.Respond(w, s.pay)
+}
+
+func cancel(pay *TypePayment.Payment, amount int32) {
+	pay.CancelAmount += amount
+	pay.CancelHistory = append(pay.CancelHistory, &TypePayment.CancelHistory{Amount: amount})
+	if pay.CancelAmount == pay.Amount {
+		pay.Status = string(util.StatusCancelled)
+	}
+}
+
+func TestCancellable(t *testing.T) {
+	pay := &TypePayment.Payment{Amount: 1000}
+	assert.Equal(t, int32(1000), Cancellable(pay))
+
+	cancel(pay, 300)
+	assert.Equal(t, int32(700), Cancellable(pay))
+
+	// cancel_amount 가 갱신되지 않은 응답이어도 cancel_history 를 반영한다.
+	stale := proto.Clone(pay).(*TypePayment.Payment)
+	stale.CancelAmount = 0
+	assert.Equal(t, int32(700), Cancellable(stale))
+
+	cancel(pay, 700)
+	assert.Equal(t, int32(0), Cancellable(pay))
+}
+
+func TestPaymentsPartialCancel(t *testing.T) {
+	s := newCancelServer()
+	iamport, server := newContractIamport(t, s.handle)
+
+	pay, err := iamport.Payments.PartialCancel("imp_1", PartialCancelRequest{Amount: 300, TaxFree: 100, Reason: "부분 환불"})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(300), pay.GetCancelAmount())
+	assert.Equal(t, "amount=300&checksum=1000&imp_uid=imp_1&reason=%EB%B6%80%EB%B6%84+%ED%99%98%EB%B6%88&tax_free=100", string(server.LastRequest().Body))
+
+	pay, err = iamport.Payments.PartialCancel("imp_1", PartialCancelRequest{Amount: 700, ExpectedRemaining: Int32(700)})
+	assert.NoError(t, err)
+	assert.Equal(t, string(util.StatusCancelled), pay.GetStatus())
+	assert.Contains(t, string(server.LastRequest().Body), "checksum=700")
+}
+
+func TestPaymentsPartialCancelExceeded(t *testing.T) {
+	s := newCancelServer()
+	iamport, server := newContractIamport(t, s.handle)
+
+	_, err := iamport.Payments.PartialCancel("imp_1", PartialCancelRequest{Amount: 1001})
+	assert.EqualError(t, err, ErrCancelAmountExceeded)
+	assert.NotEqual(t, "/payments/cancel", server.LastRequest().Path)
+
+	_, err = iamport.Payments.PartialCancel("imp_1", PartialCancelRequest{Amount: 100, TaxFree: 101})
+	assert.EqualError(t, err, ErrInvalidTaxFree)
+
+	_, err = iamport.Payments.PartialCancel("imp_1", PartialCancelRequest{})
+	assert.EqualError(t, err, ErrInvalidAmount)
+}
+
+func TestPaymentsPartialCancelExpectedRemaining(t *testing.T) {
+	s := newCancelServer()
+	iamport, server := newContractIamport(t, s.handle)
+
+	_, err := iamport.Payments.PartialCancel("imp_1", PartialCancelRequest{Amount: 100, ExpectedRemaining: Int32(900)})
+
+	var changed *BalanceChangedError
+	assert.True(t, errors.As(err, &changed))
+	assert.Equal(t, &BalanceChangedError{ImpUID: "imp_1", Expected: 900, Remaining: 1000}, changed)
+	assert.NotEqual(t, "/payments/cancel", server.LastRequest().Path)
+}
+
+func TestPaymentsPartialCancelConcurrentCancel(t *testing.T) {
+	s := newCancelServer()
+	s.beforeCancel = func(pay *TypePayment.Payment) {
+		// 다른 관리자가 먼저 500원을 취소했다.
+		cancel(pay, 500)
+	}
+	iamport, _ := newContractIamport(t, s.handle)
+
+	_, err := iamport.Payments.PartialCancel("imp_1", PartialCancelRequest{Amount: 600})
+
+	var changed *BalanceChangedError
+	assert.True(t, errors.As(err, &changed))
+	assert.Equal(t, int32(1000), changed.Expected)
+	assert.Equal(t, int32(500), changed.Remaining)
+	// 중복 환불되지 않았다.
+	assert.Equal(t, int32(500), s.pay.CancelAmount)
+}
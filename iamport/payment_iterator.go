@@ -0,0 +1,245 @@
+package iamport
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"time"
+
+	TypePayment "github.com/iamport/interface/gen_src/go/v1/payment"
+
+	"github.com/iamport/go-iamport/util"
+)
+
+const (
+	ErrInvalidCursor = "iamport: cursor is invalid"
+)
+
+// Limiter 페이지 요청 전에 호출되어 요청 속도를 제한한다.
+// golang.org/x/time/rate 의 *rate.Limiter 를 그대로 사용할 수 있다.
+type Limiter interface {
+	Wait(ctx context.Context) error
+}
+
+// ListOptions 결제 상태별 목록 조회 조건
+type ListOptions struct {
+	Status  util.PaymentStatus // 비어있으면 전체(all)
+	From    time.Time          // 비어있으면 To 기준 3개월 전
+	To      time.Time          // 비어있으면 현재 시각
+	Sorting util.Sort          // 비어있으면 아임포트 기본값(-started)
+	Limit   int                // 페이지당 건수, 0이면 아임포트 기본값(20건)
+
+	// Limiter 가 있으면 매 페이지 요청 전에 Wait 을 호출한다.
+	Limiter Limiter
+}
+
+// period 비어있는 From/To 를 아임포트 기본값으로 채운다.
+func (opts *ListOptions) period() (time.Time, time.Time) {
+	to := opts.To
+	if to.IsZero() {
+		to = time.Now()
+	}
+
+	// ListByStatus 와 같이 달력 기준 3개월로 계산한다. (90일은 2월이 포함되면 3개월을 넘는다)
+	from := opts.From
+	if from.IsZero() {
+		from = ListPeriodStart(to)
+	}
+
+	return from, to
+}
+
+// PaymentCursor 순회 위치
+// 조회 조건과 현재 페이지, 해당 페이지에서 이미 넘겨준 건수를 담고 있어
+// Encode 한 값을 저장해 두었다가 프로세스가 재시작된 뒤에도 이어서 순회할 수 있다.
+//
+// 페이지 번호 기준이므로 순회 도중 조건에 맞는 결제건이 추가되면 결과가 밀릴 수 있다.
+// 이어받기가 필요하다면 From/To 를 과거 구간으로 고정하는 것이 안전하다.
+type PaymentCursor struct {
+	MerchantUID string             `json:"merchant_uid,omitempty"` // 있으면 merchant_uid 의 모든 결제건을 순회한다.
+	Status      util.PaymentStatus `json:"status"`
+	From        int64              `json:"from"`
+	To          int64              `json:"to"`
+	Sorting     util.Sort          `json:"sorting,omitempty"`
+	Limit       int                `json:"limit,omitempty"`
+	Page        int                `json:"page"`
+	Offset      int                `json:"offset"`
+}
+
+// Encode 커서를 저장 가능한 문자열로 변환한다.
+func (c *PaymentCursor) Encode() string {
+	body, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(body)
+}
+
+// DecodePaymentCursor Encode 한 커서를 되돌린다.
+func DecodePaymentCursor(src string) (*PaymentCursor, error) {
+	body, err := base64.RawURLEncoding.DecodeString(src)
+	if err != nil {
+		return nil, errors.New(ErrInvalidCursor)
+	}
+
+	cursor := &PaymentCursor{}
+	if err := json.Unmarshal(body, cursor); err != nil {
+		return nil, errors.New(ErrInvalidCursor)
+	}
+
+	if cursor.Page < 1 || cursor.Offset < 0 || (cursor.MerchantUID == "" && cursor.From > cursor.To) {
+		return nil, errors.New(ErrInvalidCursor)
+	}
+
+	return cursor, nil
+}
+
+// PaymentIterator 결제 상태별 목록 또는 merchant_uid 별 결제건을 모든 페이지에 걸쳐 순회한다.
+//
+//	it := iam.Payments.Iterate(ctx, &iamport.ListOptions{Status: util.StatusPaid})
+//	for it.Next() {
+//		pay := it.Payment()
+//	}
+//	if err := it.Err(); err != nil {
+//	}
+type PaymentIterator struct {
+	ctx     context.Context
+	service *PaymentService
+	limiter Limiter
+	cursor  PaymentCursor
+
+	list    []*TypePayment.Payment
+	next    int32
+	fetched bool
+	current *TypePayment.Payment
+	err     error
+}
+
+// Iterate 조건에 맞는 결제건을 처음부터 순회한다.
+func (s *PaymentService) Iterate(ctx context.Context, opts *ListOptions) *PaymentIterator {
+	if opts == nil {
+		opts = &ListOptions{}
+	}
+
+	from, to := opts.period()
+
+	return s.Resume(ctx, &PaymentCursor{
+		Status:  opts.Status,
+		From:    from.Unix(),
+		To:      to.Unix(),
+		Sorting: opts.Sorting,
+		Limit:   opts.Limit,
+		Page:    1,
+	}, opts.Limiter)
+}
+
+// Resume 저장해 둔 커서 위치부터 이어서 순회한다.
+func (s *PaymentService) Resume(ctx context.Context, cursor *PaymentCursor, limiter Limiter) *PaymentIterator {
+	it := &PaymentIterator{
+		ctx:     ctx,
+		service: s,
+		limiter: limiter,
+	}
+
+	if cursor == nil || cursor.Page < 1 || cursor.Offset < 0 {
+		it.err = errors.New(ErrInvalidCursor)
+		return it
+	}
+	it.cursor = *cursor
+
+	return it
+}
+
+// Next 다음 결제건으로 이동한다. 더 이상 결제건이 없거나 오류가 발생하면 false
+func (it *PaymentIterator) Next() bool {
+	if it.err != nil {
+		return false
+	}
+
+	for it.cursor.Offset >= len(it.list) {
+		if it.fetched {
+			if it.next == 0 {
+				it.current = nil
+				return false
+			}
+
+			it.cursor.Page = int(it.next)
+			it.cursor.Offset = 0
+		}
+
+		if !it.fetch() {
+			return false
+		}
+	}
+
+	it.current = it.list[it.cursor.Offset]
+	it.cursor.Offset++
+
+	return true
+}
+
+func (it *PaymentIterator) fetch() bool {
+	if err := it.ctx.Err(); err != nil {
+		it.err = err
+		return false
+	}
+
+	if it.limiter != nil {
+		if err := it.limiter.Wait(it.ctx); err != nil {
+			it.err = err
+			return false
+		}
+	}
+
+	var (
+		page *TypePayment.PaymentPage
+		err  error
+	)
+	if it.cursor.MerchantUID != "" {
+		page, err = it.service.FindAllByMerchantUID(
+			it.cursor.MerchantUID, it.cursor.Status, it.cursor.Sorting, it.cursor.Page,
+		)
+	} else {
+		page, err = it.service.ListByStatus(
+			it.cursor.Status, it.cursor.Page, it.cursor.Limit,
+			time.Unix(it.cursor.From, 0), time.Unix(it.cursor.To, 0),
+			it.cursor.Sorting,
+		)
+	}
+	if err != nil {
+		it.err = err
+		return false
+	}
+
+	it.list = page.GetList()
+	it.next = page.GetNext()
+	it.fetched = true
+
+	return true
+}
+
+// Payment 현재 결제건
+func (it *PaymentIterator) Payment() *TypePayment.Payment {
+	return it.current
+}
+
+// Err 순회 중 발생한 오류. 모든 페이지를 순회했다면 nil
+func (it *PaymentIterator) Err() error {
+	return it.err
+}
+
+// Cursor 현재 결제건까지 처리했을 때의 위치. Resume 에 넘기면 다음 결제건부터 순회한다.
+func (it *PaymentIterator) Cursor() *PaymentCursor {
+	cursor := it.cursor
+	return &cursor
+}
+
+// ForEach 조건에 맞는 모든 결제건에 대해 fn 을 호출한다. fn 이 오류를 return 하면 순회를 멈추고 그 오류를 return 한다.
+func (s *PaymentService) ForEach(ctx context.Context, opts *ListOptions, fn func(*TypePayment.Payment) error) error {
+	it := s.Iterate(ctx, opts)
+	for it.Next() {
+		if err := fn(it.Payment()); err != nil {
+			return err
+		}
+	}
+
+	return it.Err()
+}
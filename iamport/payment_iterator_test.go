@@ -0,0 +1,155 @@
+package iamport
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	TypePayment "github.com/iamport/interface/gen_src/go/v1/payment"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/iamport/go-iamport/contract"
+	"github.com/iamport/go-iamport/util"
+)
+
+// pagedPayments 페이지당 perPage 건씩 total 건의 결제건을 응답하는 핸들러
+func pagedPayments(total, perPage int) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		if page == 0 {
+			page = 1
+		}
+
+		res := &TypePayment.PaymentPage{Total: int32(total)}
+		for i := (page - 1) * perPage; i < page*perPage && i < total; i++ {
+			res.List = append(res.List, &TypePayment.Payment{ImpUid: fmt.Sprintf("imp_%d", i)})
+		}
+		if page*perPage < total {
+			res.Next = int32(page + 1)
+		}
+
+		contract.Respond(w, res)
+	}
+}
+
+type countingLimiter struct {
+	waits int
+}
+
+func (l *countingLimiter) Wait(ctx context.Context) error {
+	l.waits++
+	return ctx.Err()
+}
+
+func collectImpUIDs(it *PaymentIterator) []string {
+	var impUIDs []string
+	for it.Next() {
+		impUIDs = append(impUIDs, it.Payment().GetImpUid())
+	}
+
+	return impUIDs
+}
+
+func TestPaymentIteratorWalksAllPages(t *testing.T) {
+	iamport, server := newContractIamport(t, pagedPayments(5, 2))
+	limiter := &countingLimiter{}
+
+	to := time.Date(2021, 1, 31, 0, 0, 0, 0, time.UTC)
+	it := iamport.Payments.Iterate(context.Background(), &ListOptions{
+		Status:  util.StatusPaid,
+		From:    to.AddDate(0, 0, -30),
+		To:      to,
+		Limit:   2,
+		Limiter: limiter,
+	})
+
+	assert.Equal(t, []string{"imp_0", "imp_1", "imp_2", "imp_3", "imp_4"}, collectImpUIDs(it))
+	assert.NoError(t, it.Err())
+	assert.Equal(t, 3, limiter.waits)
+
+	requests := server.Requests()
+	assert.Len(t, requests, 4)
+	assert.Equal(t, "/payments/status/paid", requests[3].Path)
+	assert.Equal(t, "page=3&limit=2&from=1609459200&to=1612051200", requests[3].RawQuery)
+}
+
+func TestPaymentIteratorDefaultFrom(t *testing.T) {
+	iamport, server := newContractIamport(t, pagedPayments(1, 20))
+
+	// 2월 2일 ~ 5월 2일은 89일이지만 3개월이므로 아임포트 조회 기간 제한을 넘지 않는다.
+	to := time.Date(2023, 5, 2, 0, 0, 0, 0, time.UTC)
+	it := iamport.Payments.Iterate(context.Background(), &ListOptions{To: to})
+
+	assert.Equal(t, []string{"imp_0"}, collectImpUIDs(it))
+	assert.NoError(t, it.Err())
+
+	from := time.Date(2023, 2, 2, 0, 0, 0, 0, time.UTC)
+	assert.Equal(t, fmt.Sprintf("page=1&from=%d&to=%d", from.Unix(), to.Unix()), server.LastRequest().RawQuery)
+}
+
+func TestPaymentIteratorResumeFromCursor(t *testing.T) {
+	iamport, server := newContractIamport(t, pagedPayments(5, 2))
+
+	it := iamport.Payments.Iterate(context.Background(), &ListOptions{Limit: 2})
+	for i := 0; i < 2; i++ {
+		assert.True(t, it.Next())
+	}
+	encoded := it.Cursor().Encode()
+
+	cursor, err := DecodePaymentCursor(encoded)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, cursor.Page)
+	assert.Equal(t, 2, cursor.Offset)
+
+	server.Reset()
+	resumed := iamport.Payments.Resume(context.Background(), cursor, nil)
+	assert.Equal(t, []string{"imp_2", "imp_3", "imp_4"}, collectImpUIDs(resumed))
+	assert.NoError(t, resumed.Err())
+	// 1페이지는 이미 다 넘겨주었으므로 다시 받아온 뒤 바로 다음 페이지로 넘어간다.
+	assert.Len(t, server.Requests(), 3)
+}
+
+func TestPaymentIteratorContextCancel(t *testing.T) {
+	iamport, _ := newContractIamport(t, pagedPayments(5, 2))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	it := iamport.Payments.Iterate(ctx, &ListOptions{Limit: 2})
+	assert.True(t, it.Next())
+	assert.True(t, it.Next())
+	cancel()
+
+	assert.False(t, it.Next())
+	assert.Equal(t, context.Canceled, it.Err())
+	// 다음 페이지를 받아오기 전에 취소되었으므로 다음 페이지 처음부터 이어받는다.
+	assert.Equal(t, 2, it.Cursor().Page)
+	assert.Equal(t, 0, it.Cursor().Offset)
+}
+
+func TestPaymentsForEachStopsOnError(t *testing.T) {
+	iamport, _ := newContractIamport(t, pagedPayments(5, 2))
+
+	stop := errors.New("stop")
+	count := 0
+	err := iamport.Payments.ForEach(context.Background(), &ListOptions{Limit: 2}, func(*TypePayment.Payment) error {
+		count++
+		if count == 3 {
+			return stop
+		}
+		return nil
+	})
+
+	assert.Equal(t, stop, err)
+	assert.Equal(t, 3, count)
+}
+
+func TestDecodePaymentCursorInvalid(t *testing.T) {
+	_, err := DecodePaymentCursor("not-a-cursor")
+	assert.EqualError(t, err, ErrInvalidCursor)
+
+	_, err = DecodePaymentCursor((&PaymentCursor{Page: 0}).Encode())
+	assert.EqualError(t, err, ErrInvalidCursor)
+}
@@ -0,0 +1,102 @@
+package iamport
+
+import (
+	"context"
+	"errors"
+
+	TypePayment "github.com/iamport/interface/gen_src/go/v1/payment"
+
+	"github.com/iamport/go-iamport/util"
+)
+
+const ErrNotFoundPayment = "iamport: payment not found"
+
+// IterateMerchantUID merchant_uid 로 결제된 모든 결제 시도를 순회한다.
+// status 가 비어있으면 모든 상태의 결제건을 순회한다.
+//
+// GET /payments/findAll/{merchant_uid}/{payment_status}
+func (s *PaymentService) IterateMerchantUID(ctx context.Context, muid string, status util.PaymentStatus, sorting util.Sort) *PaymentIterator {
+	if muid == "" {
+		return &PaymentIterator{err: errors.New(ErrMustExistMerchantUID)}
+	}
+
+	return s.Resume(ctx, &PaymentCursor{
+		MerchantUID: muid,
+		Status:      status,
+		Sorting:     sorting,
+		Page:        1,
+	}, nil)
+}
+
+// FindAllAttempts merchant_uid 로 결제된 모든 결제 시도를 모든 페이지에 걸쳐 가져온다.
+// 결제 시도가 하나도 없으면 ErrNotFoundPayment 를 return 해준다.
+func (s *PaymentService) FindAllAttempts(ctx context.Context, muid string, status util.PaymentStatus) ([]*TypePayment.Payment, error) {
+	var payments []*TypePayment.Payment
+
+	it := s.IterateMerchantUID(ctx, muid, status, "")
+	for it.Next() {
+		payments = append(payments, it.Payment())
+	}
+
+	if err := it.Err(); err != nil {
+		// 결제 시도가 없는 merchant_uid 는 아임포트가 404 로 응답한다.
+		if err.Error() == util.ErrStatusNotFound {
+			return nil, errors.New(ErrNotFoundPayment)
+		}
+		return nil, err
+	}
+
+	return payments, nil
+}
+
+// FindAuthoritative merchant_uid 의 결제 시도 중 주문 처리 기준이 되는 결제건을 가져온다.
+// 결제 시도가 하나도 없으면 ErrNotFoundPayment 를 return 해준다.
+func (s *PaymentService) FindAuthoritative(ctx context.Context, muid string) (*TypePayment.Payment, error) {
+	payments, err := s.FindAllAttempts(ctx, muid, "")
+	if err != nil {
+		return nil, err
+	}
+
+	authoritative := AuthoritativeAttempt(payments)
+	if authoritative == nil {
+		return nil, errors.New(ErrNotFoundPayment)
+	}
+
+	return authoritative, nil
+}
+
+// AuthoritativeAttempt 같은 merchant_uid 로 여러 번 시도된 결제건 중 주문 처리 기준이 되는 결제건을 고른다.
+//
+// 결제완료(paid) 건이 있으면 가장 늦게 결제완료된 건을, 없으면 가장 늦게 시작된 건을 return 해준다.
+// 시각이 같으면 imp_uid 가 큰 건을 고르며, payments 가 비어있으면 nil
+func AuthoritativeAttempt(payments []*TypePayment.Payment) *TypePayment.Payment {
+	var latestPaid, latest *TypePayment.Payment
+
+	for _, pay := range payments {
+		if util.PaymentStatus(pay.GetStatus()).IsPaid() && isLater(pay, latestPaid, pay.GetPaidAt(), latestPaid.GetPaidAt()) {
+			latestPaid = pay
+		}
+
+		if isLater(pay, latest, pay.GetStartedAt(), latest.GetStartedAt()) {
+			latest = pay
+		}
+	}
+
+	if latestPaid != nil {
+		return latestPaid
+	}
+
+	return latest
+}
+
+func isLater(pay, than *TypePayment.Payment, at, thanAt int32) bool {
+	if than == nil {
+		return true
+	}
+
+	if at != thanAt {
+		return at > thanAt
+	}
+
+	return pay.GetImpUid() > than.GetImpUid()
+}
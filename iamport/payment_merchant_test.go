@@ -0,0 +1,114 @@
+package iamport
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"testing"
+
+	TypePayment "github.com/iamport/interface/gen_src/go/v1/payment"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/iamport/go-iamport/contract"
+	"github.com/iamport/go-iamport/util"
+)
+
+// merchantAttempts 페이지당 2건씩 attempts 를 응답하는 /payments/findAll 핸들러
+func merchantAttempts(attempts []*TypePayment.Payment) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		if page == 0 {
+			page = 1
+		}
+
+		res := &TypePayment.PaymentPage{Total: int32(len(attempts))}
+		for i := (page - 1) * 2; i < page*2 && i < len(attempts); i++ {
+			res.List = append(res.List, attempts[i])
+		}
+		if page*2 < len(attempts) {
+			res.Next = int32(page + 1)
+		}
+
+		contract.Respond(w, res)
+	}
+}
+
+var retriedAttempts = []*TypePayment.Payment{
+	{ImpUid: "imp_4", Status: string(util.StatusFailed), StartedAt: 400},
+	{ImpUid: "imp_3", Status: string(util.StatusPaid), StartedAt: 300, PaidAt: 310},
+	{ImpUid: "imp_2", Status: string(util.StatusCancelled), StartedAt: 200, PaidAt: 210},
+	{ImpUid: "imp_1", Status: string(util.StatusPaid), StartedAt: 100, PaidAt: 110},
+	{ImpUid: "imp_0", Status: string(util.StatusReady), StartedAt: 50},
+}
+
+func TestPaymentsFindAllAttempts(t *testing.T) {
+	iamport, server := newContractIamport(t, merchantAttempts(retriedAttempts))
+
+	payments, err := iamport.Payments.FindAllAttempts(context.Background(), "order_1", util.StatusPaid)
+	assert.NoError(t, err)
+	assert.Len(t, payments, 5)
+
+	requests := server.Requests()
+	assert.Len(t, requests, 4)
+	assert.Equal(t, "/payments/findAll/order_1/paid", requests[1].Path)
+	assert.Equal(t, "page=3", requests[3].RawQuery)
+}
+
+func TestPaymentsFindAuthoritative(t *testing.T) {
+	iamport, _ := newContractIamport(t, merchantAttempts(retriedAttempts))
+
+	pay, err := iamport.Payments.FindAuthoritative(context.Background(), "order_1")
+	assert.NoError(t, err)
+	assert.Equal(t, "imp_3", pay.GetImpUid())
+
+	_, err = iamport.Payments.FindAuthoritative(context.Background(), "")
+	assert.EqualError(t, err, ErrMustExistMerchantUID)
+}
+
+func TestPaymentsFindAuthoritativeWithoutAttempts(t *testing.T) {
+	// 결제 시도가 없는 merchant_uid 는 아임포트가 404 로 응답한다.
+	iamport, server := newContractIamport(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		contract.RespondError(w, "존재하지 않는 결제정보입니다.")
+	})
+
+	payments, err := iamport.Payments.FindAllAttempts(context.Background(), "order_1", "")
+	assert.EqualError(t, err, ErrNotFoundPayment)
+	assert.Nil(t, payments)
+	assert.Equal(t, "/payments/findAll/order_1/", server.LastRequest().Path)
+
+	pay, err := iamport.Payments.FindAuthoritative(context.Background(), "order_1")
+	assert.EqualError(t, err, ErrNotFoundPayment)
+	assert.Nil(t, pay)
+}
+
+func TestAuthoritativeAttempt(t *testing.T) {
+	assert.Nil(t, AuthoritativeAttempt(nil))
+
+	// 결제완료 건이 없으면 가장 늦게 시작된 건
+	assert.Equal(t, "imp_4", AuthoritativeAttempt(retriedAttempts[:1]).GetImpUid())
+	assert.Equal(t, "imp_4", AuthoritativeAttempt([]*TypePayment.Payment{
+		retriedAttempts[2], retriedAttempts[0], retriedAttempts[4],
+	}).GetImpUid())
+
+	// 결제완료 건이 여러 개면 가장 늦게 결제완료된 건
+	assert.Equal(t, "imp_3", AuthoritativeAttempt(retriedAttempts).GetImpUid())
+	assert.Equal(t, "imp_1", AuthoritativeAttempt(retriedAttempts[2:]).GetImpUid())
+}
+
+func TestPaymentCursorResumeMerchantUID(t *testing.T) {
+	iamport, _ := newContractIamport(t, merchantAttempts(retriedAttempts))
+
+	it := iamport.Payments.IterateMerchantUID(context.Background(), "order_1", "", "")
+	for i := 0; i < 3; i++ {
+		assert.True(t, it.Next())
+	}
+
+	cursor, err := DecodePaymentCursor(it.Cursor().Encode())
+	assert.NoError(t, err)
+	assert.Equal(t, "order_1", cursor.MerchantUID)
+
+	resumed := iamport.Payments.Resume(context.Background(), cursor, nil)
+	assert.Equal(t, []string{"imp_1", "imp_0"}, collectImpUIDs(resumed))
+	assert.NoError(t, resumed.Err())
+}
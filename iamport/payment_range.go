@@ -0,0 +1,238 @@
+package iamport
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+	"time"
+
+	TypePayment "github.com/iamport/interface/gen_src/go/v1/payment"
+
+	"github.com/iamport/go-iamport/util"
+)
+
+// MaxListMonths 결제 상태별 목록 조회 한 번에 지정할 수 있는 최대 기간 (개월)
+// 아임포트는 달력 기준으로 from 의 3개월 뒤보다 늦은 to 를 거절하므로 일수가 아니라 AddDate 로 계산한다.
+const MaxListMonths = 3
+
+// addListMonths t 에 KST 달력 기준으로 months 개월을 더한다. t 의 시간대는 유지한다.
+// 조회 기간의 개월 수는 시간대에 따라 달라지므로 항상 KST 달력으로 계산한다.
+func addListMonths(t time.Time, months int) time.Time {
+	return t.In(util.KST).AddDate(0, months, 0).In(t.Location())
+}
+
+// ListPeriodStart to 까지 한 번에 조회할 수 있는 가장 이른 from
+func ListPeriodStart(to time.Time) time.Time {
+	return addListMonths(to, -MaxListMonths)
+}
+
+// Period 조회 기간 [From, To]
+type Period struct {
+	From time.Time
+	To   time.Time
+}
+
+// SplitPeriod from ~ to 구간을 MaxListMonths 이하의 연속된 구간으로 나눈다.
+// 인접한 구간은 경계 시각을 공유하므로 경계에 걸친 결제건은 중복 조회될 수 있다.
+func SplitPeriod(from, to time.Time) []Period {
+	if from.After(to) {
+		return nil
+	}
+
+	var periods []Period
+	for start := from; ; {
+		end := addListMonths(start, MaxListMonths)
+		if !end.Before(to) {
+			periods = append(periods, Period{From: start, To: to})
+			return periods
+		}
+
+		periods = append(periods, Period{From: start, To: end})
+		start = end
+	}
+}
+
+// RangeOptions 기간 제한 없는 결제 상태별 목록 조회 조건
+type RangeOptions struct {
+	ListOptions
+
+	// Concurrency 동시에 조회할 구간 수. 0 또는 1이면 구간을 순서대로 조회한다.
+	// Limiter 는 모든 구간이 공유한다.
+	Concurrency int
+}
+
+// ListRange from ~ to 구간을 3개월 단위로 나누어 조회한 뒤
+// imp_uid 기준으로 중복을 제거하고 Sorting 순서로 합쳐서 return 해준다.
+//
+// updated 정렬의 경우 결제 정보에 최종수정시각이 없으므로 UpdatedAt 을 기준으로 정렬한다.
+func (s *PaymentService) ListRange(ctx context.Context, opts *RangeOptions) ([]*TypePayment.Payment, error) {
+	if opts == nil {
+		opts = &RangeOptions{}
+	}
+
+	from, to := opts.period()
+	if from.After(to) {
+		return nil, errors.New(ErrInvalidFrom)
+	}
+
+	periods := SplitPeriod(from, to)
+	results := make([][]*TypePayment.Payment, len(periods))
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	concurrency := opts.Concurrency
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	var (
+		wg       sync.WaitGroup
+		errOnce  sync.Once
+		firstErr error
+		sem      = make(chan struct{}, concurrency)
+	)
+
+	for i, period := range periods {
+		sem <- struct{}{}
+		if ctx.Err() != nil {
+			<-sem
+			break
+		}
+
+		wg.Add(1)
+		go func(i int, period Period) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			windowOpts := opts.ListOptions
+			windowOpts.From = period.From
+			windowOpts.To = period.To
+
+			it := s.Iterate(ctx, &windowOpts)
+			for it.Next() {
+				results[i] = append(results[i], it.Payment())
+			}
+
+			if err := it.Err(); err != nil {
+				errOnce.Do(func() {
+					firstErr = err
+					cancel()
+				})
+			}
+		}(i, period)
+	}
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	return mergePayments(results, opts.Sorting), nil
+}
+
+// ForEachRange from ~ to 구간을 3개월 단위로 나누어 순서대로 조회하면서 결제건마다 fn 을 호출한다.
+// ListRange 와 달리 결제건을 모아두지 않으며, 구간 경계에서 중복 조회된 결제건은 한 번만 넘겨준다.
+// 결제건은 구간 순서, 구간 안에서는 Sorting 순서로 넘겨준다.
+// fn 이 오류를 return 하면 순회를 멈추고 그 오류를 return 한다.
+func (s *PaymentService) ForEachRange(ctx context.Context, opts *ListOptions, fn func(*TypePayment.Payment) error) error {
+	if opts == nil {
+		opts = &ListOptions{}
+	}
+
+	from, to := opts.period()
+	if from.After(to) {
+		return errors.New(ErrInvalidFrom)
+	}
+
+	seen := map[string]bool{}
+	for _, period := range SplitPeriod(from, to) {
+		windowOpts := *opts
+		windowOpts.From = period.From
+		windowOpts.To = period.To
+
+		err := s.ForEach(ctx, &windowOpts, func(payment *TypePayment.Payment) error {
+			if seen[payment.GetImpUid()] {
+				return nil
+			}
+			seen[payment.GetImpUid()] = true
+
+			return fn(payment)
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// mergePayments imp_uid 기준으로 중복을 제거하고 sorting 순서로 정렬한다.
+func mergePayments(results [][]*TypePayment.Payment, sorting util.Sort) []*TypePayment.Payment {
+	seen := map[string]bool{}
+	var merged []*TypePayment.Payment
+	for _, payments := range results {
+		for _, payment := range payments {
+			if seen[payment.GetImpUid()] {
+				continue
+			}
+
+			seen[payment.GetImpUid()] = true
+			merged = append(merged, payment)
+		}
+	}
+
+	if sorting == "" {
+		sorting = util.SortDESCStarted
+	}
+
+	sort.SliceStable(merged, func(i, j int) bool {
+		a, b := sortKey(merged[i], sorting), sortKey(merged[j], sorting)
+		if a == b {
+			return merged[i].GetImpUid() < merged[j].GetImpUid()
+		}
+
+		if sorting.IsDescending() {
+			return a > b
+		}
+
+		return a < b
+	})
+
+	return merged
+}
+
+func sortKey(payment *TypePayment.Payment, sorting util.Sort) int32 {
+	switch sorting.Field() {
+	case "paid":
+		return payment.GetPaidAt()
+	case "updated":
+		return UpdatedAt(payment)
+	default:
+		return payment.GetStartedAt()
+	}
+}
+
+// UpdatedAt 결제건의 최종수정시각 (unix timestamp)
+// 결제 정보에 최종수정시각이 없으므로 결제시작, 결제완료, 결제실패, 취소 시각과 취소 내역 중 가장 늦은 시각을 사용한다.
+func UpdatedAt(payment *TypePayment.Payment) int32 {
+	updated := payment.GetStartedAt()
+	for _, at := range []int32{payment.GetPaidAt(), payment.GetFailedAt(), payment.GetCancelledAt()} {
+		if at > updated {
+			updated = at
+		}
+	}
+
+	for _, history := range payment.GetCancelHistory() {
+		if history.GetCancelledAt() > updated {
+			updated = history.GetCancelledAt()
+		}
+	}
+
+	return updated
+}
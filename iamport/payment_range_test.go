@@ -0,0 +1,190 @@
+package iamport
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	TypePayment "github.com/iamport/interface/gen_src/go/v1/payment"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/iamport/go-iamport/contract"
+	"github.com/iamport/go-iamport/util"
+)
+
+var rangeStart = time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// dailyPayments 2020-01-01 부터 하루에 한 건씩 시작된 결제건 중 from ~ to (경계 포함) 구간을 응답하는 핸들러
+func dailyPayments(days int, calls *int32) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(calls, 1)
+
+		from, _ := strconv.ParseInt(r.URL.Query().Get("from"), 10, 64)
+		to, _ := strconv.ParseInt(r.URL.Query().Get("to"), 10, 64)
+
+		res := &TypePayment.PaymentPage{}
+		for day := days - 1; day >= 0; day-- {
+			startedAt := rangeStart.AddDate(0, 0, day).Unix()
+			if startedAt < from || startedAt > to {
+				continue
+			}
+
+			res.List = append(res.List, &TypePayment.Payment{
+				ImpUid:    fmt.Sprintf("imp_%03d", day),
+				StartedAt: int32(startedAt),
+				PaidAt:    int32(rangeStart.AddDate(0, 0, days-day).Unix()),
+			})
+		}
+		res.Total = int32(len(res.List))
+
+		contract.Respond(w, res)
+	}
+}
+
+func TestSplitPeriod(t *testing.T) {
+	to := rangeStart.AddDate(1, 0, 0)
+	periods := SplitPeriod(rangeStart, to)
+
+	assert.Len(t, periods, 4)
+	assert.Equal(t, rangeStart, periods[0].From)
+	for i := 1; i < len(periods); i++ {
+		assert.Equal(t, periods[i-1].To, periods[i].From)
+		assert.False(t, periods[i].From.AddDate(0, MaxListMonths, 0).Before(periods[i].To))
+	}
+	assert.Equal(t, to, periods[3].To)
+
+	assert.Equal(t, []Period{{From: rangeStart, To: rangeStart}}, SplitPeriod(rangeStart, rangeStart))
+	assert.Nil(t, SplitPeriod(to, rangeStart))
+}
+
+func TestSplitPeriodFromFebruary(t *testing.T) {
+	// 2월 1일 ~ 5월 1일은 89일이다. 90일 단위로 나누면 첫 구간이 3개월을 넘는다.
+	from := time.Date(2023, 2, 1, 0, 0, 0, 0, time.UTC)
+	periods := SplitPeriod(from, from.AddDate(1, 0, 0))
+
+	assert.Equal(t, []Period{
+		{From: from, To: time.Date(2023, 5, 1, 0, 0, 0, 0, time.UTC)},
+		{From: time.Date(2023, 5, 1, 0, 0, 0, 0, time.UTC), To: time.Date(2023, 8, 1, 0, 0, 0, 0, time.UTC)},
+		{From: time.Date(2023, 8, 1, 0, 0, 0, 0, time.UTC), To: time.Date(2023, 11, 1, 0, 0, 0, 0, time.UTC)},
+		{From: time.Date(2023, 11, 1, 0, 0, 0, 0, time.UTC), To: time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)},
+	}, periods)
+
+	assert.Equal(t, time.Date(2023, 2, 2, 0, 0, 0, 0, time.UTC), ListPeriodStart(time.Date(2023, 5, 2, 0, 0, 0, 0, time.UTC)))
+}
+
+func TestPaymentsListRangeOverYear(t *testing.T) {
+	for _, concurrency := range []int{0, 4} {
+		t.Run(fmt.Sprintf("concurrency=%d", concurrency), func(t *testing.T) {
+			var calls int32
+			iamport, _ := newContractIamport(t, dailyPayments(366, &calls))
+
+			payments, err := iamport.Payments.ListRange(context.Background(), &RangeOptions{
+				ListOptions: ListOptions{
+					Status: util.StatusPaid,
+					From:   rangeStart,
+					To:     rangeStart.AddDate(1, 0, 0).Add(-time.Second),
+				},
+				Concurrency: concurrency,
+			})
+			assert.NoError(t, err)
+			assert.Equal(t, int32(4), atomic.LoadInt32(&calls))
+
+			// 구간 경계에 걸친 결제건은 한 번만 포함되고 -started 순으로 정렬된다.
+			assert.Len(t, payments, 366)
+			assert.Equal(t, "imp_365", payments[0].GetImpUid())
+			assert.Equal(t, "imp_000", payments[365].GetImpUid())
+		})
+	}
+}
+
+func TestPaymentsListRangeFromFebruary(t *testing.T) {
+	var calls int32
+	iamport, server := newContractIamport(t, dailyPayments(0, &calls))
+
+	from := time.Date(2023, 2, 1, 0, 0, 0, 0, time.UTC)
+	_, err := iamport.Payments.ListRange(context.Background(), &RangeOptions{
+		ListOptions: ListOptions{From: from, To: from.AddDate(1, 0, 0)},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(4), atomic.LoadInt32(&calls))
+	assert.Equal(t, fmt.Sprintf("page=1&from=%d&to=%d", from.Unix(), from.AddDate(0, 3, 0).Unix()), server.Requests()[1].RawQuery)
+}
+
+func TestPaymentsListRangeInKST(t *testing.T) {
+	var calls int32
+	iamport, _ := newContractIamport(t, dailyPayments(0, &calls))
+
+	// 5월 1일 KST ~ 8월 1일 KST 구간은 UTC 달력으로 계산하면 3개월을 넘는다.
+	// 구간을 나눌 때와 조회 기간을 확인할 때 모두 KST 달력으로 계산한다.
+	kst := time.FixedZone("KST", 9*60*60)
+	from := time.Date(2023, 2, 1, 0, 0, 0, 0, kst)
+	_, err := iamport.Payments.ListRange(context.Background(), &RangeOptions{
+		ListOptions: ListOptions{From: from, To: from.AddDate(1, 0, 0)},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(4), atomic.LoadInt32(&calls))
+
+	for _, period := range SplitPeriod(from.In(time.UTC), from.AddDate(1, 0, 0).In(time.UTC)) {
+		assert.Equal(t, time.UTC, period.From.Location())
+		_, err := iamport.Payments.ListByStatus(util.StatusAll, 1, 0, period.From, period.To, "")
+		assert.NoError(t, err)
+	}
+}
+
+func TestPaymentsForEachRange(t *testing.T) {
+	var calls int32
+	iamport, _ := newContractIamport(t, dailyPayments(366, &calls))
+
+	var impUIDs []string
+	err := iamport.Payments.ForEachRange(context.Background(), &ListOptions{
+		From: rangeStart,
+		To:   rangeStart.AddDate(1, 0, 0),
+	}, func(payment *TypePayment.Payment) error {
+		impUIDs = append(impUIDs, payment.GetImpUid())
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(4), atomic.LoadInt32(&calls))
+	// 구간 경계(4월 1일 등)에 걸친 결제건은 한 번만 넘겨준다.
+	assert.Len(t, impUIDs, 366)
+	assert.Equal(t, "imp_091", impUIDs[0])
+
+	err = iamport.Payments.ForEachRange(context.Background(), &ListOptions{From: rangeStart.AddDate(0, 0, 1), To: rangeStart}, nil)
+	assert.EqualError(t, err, ErrInvalidFrom)
+}
+
+func TestPaymentsListRangeSortByPaid(t *testing.T) {
+	var calls int32
+	iamport, _ := newContractIamport(t, dailyPayments(100, &calls))
+
+	payments, err := iamport.Payments.ListRange(context.Background(), &RangeOptions{
+		ListOptions: ListOptions{
+			From:    rangeStart,
+			To:      rangeStart.AddDate(0, 0, 100),
+			Sorting: util.SortASCPaid,
+		},
+	})
+	assert.NoError(t, err)
+	assert.Len(t, payments, 100)
+	// 늦게 시작된 결제건일수록 먼저 결제되었다.
+	assert.Equal(t, "imp_099", payments[0].GetImpUid())
+	assert.Equal(t, "imp_000", payments[99].GetImpUid())
+}
+
+func TestPaymentsListRangeStopsOnError(t *testing.T) {
+	iamport, server := newContractIamport(t, func(w http.ResponseWriter, r *http.Request) {
+		contract.RespondError(w, "조회 실패")
+	})
+
+	payments, err := iamport.Payments.ListRange(context.Background(), &RangeOptions{
+		ListOptions: ListOptions{From: rangeStart, To: rangeStart.AddDate(1, 0, 0)},
+	})
+	assert.EqualError(t, err, "조회 실패")
+	assert.Nil(t, payments)
+	// 순차 조회는 첫 구간의 오류에서 멈춘다.
+	assert.Len(t, server.Requests(), 2)
+}
@@ -0,0 +1,150 @@
+package iamport
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	TypePayment "github.com/iamport/interface/gen_src/go/v1/payment"
+
+	"github.com/iamport/go-iamport/util"
+)
+
+const (
+	ErrMustExistTargetStatus = "iamport: target status must be exist"
+
+	DefaultWaitInitialInterval = time.Second
+	DefaultWaitMaxInterval     = 30 * time.Second
+	DefaultWaitMultiplier      = 2.0
+)
+
+// WaitOptions 결제 상태 대기 조건
+type WaitOptions struct {
+	InitialInterval time.Duration // 첫 재조회 간격, 0이면 1초
+	MaxInterval     time.Duration // 최대 재조회 간격, 0이면 30초
+	Multiplier      float64       // 재조회마다 간격에 곱하는 값, 1 미만이면 2
+
+	// Timeout 0보다 크면 ctx 와 별개로 최대 대기 시간을 제한한다.
+	Timeout time.Duration
+
+	// OnTransition 결제 상태가 바뀔 때마다 호출된다. 첫 조회 시에는 prev 가 nil
+	OnTransition func(prev, current *TypePayment.Payment)
+}
+
+// TerminalStatusError 기다리던 상태가 되기 전에 더 이상 바뀌지 않는 상태(결제실패, 결제취소)가 된 경우
+type TerminalStatusError struct {
+	Payment *TypePayment.Payment
+	Status  util.PaymentStatus
+}
+
+func (e *TerminalStatusError) Error() string {
+	return fmt.Sprintf("iamport: payment %s reached terminal status %s", e.Payment.GetImpUid(), e.Status)
+}
+
+// WaitForStatus 결제 상태가 targets 중 하나가 될 때까지 간격을 늘려가며 결제 정보를 다시 가져온다.
+//
+// 가상계좌 입금처럼 비동기로 완료되는 결제에 사용한다.
+// targets 에 없는 결제실패, 결제취소 상태가 되면 더 기다리지 않고 *TerminalStatusError 를 return 해준다.
+// 대기 시간이 지나면 ctx.Err() (context.DeadlineExceeded 등) 를 return 해준다.
+//
+// 네트워크 오류, 5xx 응답 같은 일시적인 조회 오류는 대기 시간이 끝날 때까지 다시 조회하며,
+// 결제건이 없거나(404) 인증에 실패한 경우(401)에만 바로 오류를 return 해준다.
+//
+// GET /payments/{imp_uid}
+func (s *PaymentService) WaitForStatus(ctx context.Context, impUID string, targets []util.PaymentStatus, opts *WaitOptions) (*TypePayment.Payment, error) {
+	if impUID == "" {
+		return nil, errors.New(ErrMustExistImpUID)
+	}
+
+	if len(targets) == 0 {
+		return nil, errors.New(ErrMustExistTargetStatus)
+	}
+
+	if opts == nil {
+		opts = &WaitOptions{}
+	}
+
+	if opts.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, opts.Timeout)
+		defer cancel()
+	}
+
+	interval := opts.InitialInterval
+	if interval <= 0 {
+		interval = DefaultWaitInitialInterval
+	}
+
+	maxInterval := opts.MaxInterval
+	if maxInterval <= 0 {
+		maxInterval = DefaultWaitMaxInterval
+	}
+
+	multiplier := opts.Multiplier
+	if multiplier < 1 {
+		multiplier = DefaultWaitMultiplier
+	}
+
+	var prev *TypePayment.Payment
+	for {
+		if err := ctx.Err(); err != nil {
+			return prev, err
+		}
+
+		pay, err := s.Get(impUID)
+		if err != nil && !isTransientWaitError(err) {
+			return prev, err
+		}
+
+		if err == nil {
+			status := util.PaymentStatus(pay.GetStatus())
+			if opts.OnTransition != nil && (prev == nil || prev.GetStatus() != pay.GetStatus()) {
+				opts.OnTransition(prev, pay)
+			}
+			prev = pay
+
+			if containsStatus(targets, status) {
+				return pay, nil
+			}
+
+			if status.IsFinal() {
+				return pay, &TerminalStatusError{Payment: pay, Status: status}
+			}
+		}
+
+		timer := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return prev, ctx.Err()
+		case <-timer.C:
+		}
+
+		interval = time.Duration(float64(interval) * multiplier)
+		if interval > maxInterval {
+			interval = maxInterval
+		}
+	}
+}
+
+// isTransientWaitError 다시 조회하면 성공할 수 있는 오류인지 확인한다.
+// 결제건이 없거나 인증에 실패한 경우는 다시 조회해도 같으므로 제외한다.
+func isTransientWaitError(err error) bool {
+	switch err.Error() {
+	case util.ErrStatusNotFound, util.ErrStatusUnauthorized:
+		return false
+	default:
+		return true
+	}
+}
+
+func containsStatus(statuses []util.PaymentStatus, status util.PaymentStatus) bool {
+	for _, s := range statuses {
+		if s == status {
+			return true
+		}
+	}
+
+	return false
+}
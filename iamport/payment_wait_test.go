@@ -0,0 +1,140 @@
+package iamport
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	TypePayment "github.com/iamport/interface/gen_src/go/v1/payment"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/iamport/go-iamport/contract"
+	"github.com/iamport/go-iamport/util"
+)
+
+var fastWait = &WaitOptions{InitialInterval: time.Millisecond, MaxInterval: 4 * time.Millisecond}
+
+// statusSequence 조회할 때마다 statuses 의 다음 상태를 응답한다. 마지막 상태는 계속 유지된다.
+func statusSequence(statuses ...util.PaymentStatus) http.HandlerFunc {
+	var (
+		mu    sync.Mutex
+		calls int
+	)
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		i := calls
+		if i >= len(statuses) {
+			i = len(statuses) - 1
+		}
+		calls++
+
+		contract.Respond(w, &TypePayment.Payment{ImpUid: "imp_1", Status: string(statuses[i])})
+	}
+}
+
+func TestPaymentsWaitForStatus(t *testing.T) {
+	iamport, server := newContractIamport(t, statusSequence(util.StatusReady, util.StatusReady, util.StatusPaid))
+
+	var transitions []string
+	opts := *fastWait
+	opts.OnTransition = func(prev, current *TypePayment.Payment) {
+		transitions = append(transitions, prev.GetStatus()+"->"+current.GetStatus())
+	}
+
+	pay, err := iamport.Payments.WaitForStatus(context.Background(), "imp_1", []util.PaymentStatus{util.StatusPaid}, &opts)
+	assert.NoError(t, err)
+	assert.Equal(t, string(util.StatusPaid), pay.GetStatus())
+	assert.Equal(t, []string{"->ready", "ready->paid"}, transitions)
+	assert.Len(t, server.Requests(), 4)
+}
+
+func TestPaymentsWaitForStatusTerminal(t *testing.T) {
+	iamport, _ := newContractIamport(t, statusSequence(util.StatusReady, util.StatusFailed))
+
+	pay, err := iamport.Payments.WaitForStatus(context.Background(), "imp_1", []util.PaymentStatus{util.StatusPaid}, fastWait)
+
+	var terminal *TerminalStatusError
+	assert.True(t, errors.As(err, &terminal))
+	assert.Equal(t, util.StatusFailed, terminal.Status)
+	assert.Equal(t, string(util.StatusFailed), pay.GetStatus())
+}
+
+func TestPaymentsWaitForStatusTargetIsTerminal(t *testing.T) {
+	iamport, _ := newContractIamport(t, statusSequence(util.StatusPaid, util.StatusCancelled))
+
+	pay, err := iamport.Payments.WaitForStatus(context.Background(), "imp_1", []util.PaymentStatus{util.StatusCancelled}, fastWait)
+	assert.NoError(t, err)
+	assert.Equal(t, string(util.StatusCancelled), pay.GetStatus())
+}
+
+func TestPaymentsWaitForStatusTimeout(t *testing.T) {
+	iamport, _ := newContractIamport(t, statusSequence(util.StatusReady))
+
+	opts := *fastWait
+	opts.Timeout = 20 * time.Millisecond
+
+	pay, err := iamport.Payments.WaitForStatus(context.Background(), "imp_1", []util.PaymentStatus{util.StatusPaid}, &opts)
+	assert.Equal(t, context.DeadlineExceeded, err)
+	// 마지막으로 조회한 결제 정보는 함께 return 된다.
+	assert.Equal(t, string(util.StatusReady), pay.GetStatus())
+}
+
+func TestPaymentsWaitForStatusRetriesTransientErrors(t *testing.T) {
+	next := statusSequence(util.StatusReady, util.StatusPaid)
+	calls := 0
+	iamport, server := newContractIamport(t, func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		// 두 번째, 세 번째 조회는 일시적인 서버 오류
+		if calls == 2 || calls == 3 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		next(w, r)
+	})
+
+	pay, err := iamport.Payments.WaitForStatus(context.Background(), "imp_1", []util.PaymentStatus{util.StatusPaid}, fastWait)
+	assert.NoError(t, err)
+	assert.Equal(t, string(util.StatusPaid), pay.GetStatus())
+	assert.Len(t, server.Requests(), 5)
+}
+
+func TestPaymentsWaitForStatusStopsOnNotFound(t *testing.T) {
+	iamport, server := newContractIamport(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		contract.RespondError(w, "존재하지 않는 결제정보입니다.")
+	})
+
+	pay, err := iamport.Payments.WaitForStatus(context.Background(), "imp_1", []util.PaymentStatus{util.StatusPaid}, fastWait)
+	assert.EqualError(t, err, util.ErrStatusNotFound)
+	assert.Nil(t, pay)
+	assert.Len(t, server.Requests(), 2)
+}
+
+func TestPaymentsWaitForStatusTransientUntilTimeout(t *testing.T) {
+	iamport, _ := newContractIamport(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	opts := *fastWait
+	opts.Timeout = 20 * time.Millisecond
+
+	pay, err := iamport.Payments.WaitForStatus(context.Background(), "imp_1", []util.PaymentStatus{util.StatusPaid}, &opts)
+	assert.Equal(t, context.DeadlineExceeded, err)
+	assert.Nil(t, pay)
+}
+
+func TestPaymentsWaitForStatusInvalidParams(t *testing.T) {
+	iamport, _ := newContractIamport(t, nil)
+
+	_, err := iamport.Payments.WaitForStatus(context.Background(), "", []util.PaymentStatus{util.StatusPaid}, nil)
+	assert.EqualError(t, err, ErrMustExistImpUID)
+
+	_, err = iamport.Payments.WaitForStatus(context.Background(), "imp_1", nil, nil)
+	assert.EqualError(t, err, ErrMustExistTargetStatus)
+}
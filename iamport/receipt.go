@@ -0,0 +1,127 @@
+package iamport
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/iamport/go-iamport/receipt"
+	"github.com/iamport/go-iamport/util"
+)
+
+const (
+	ErrMustExistReceiptIdentifier = "iamport: receipt identifier must be exist"
+	ErrInvalidReceiptIdentifier   = "iamport: receipt identifier does not match identifier type. must be person, phone, business and taxcard"
+	ErrInvalidReceiptPurpose      = "iamport: receipt type is invalid. must be person and company"
+	ErrInvalidReceiptTaxFree      = "iamport: receipt tax_free must not be negative"
+)
+
+// ReceiptService 현금영수증 API
+type ReceiptService service
+
+// CashReceipt 현금영수증 발급 정보
+type CashReceipt struct {
+	Identifier     string                 // 주민등록번호, 휴대폰 번호, 사업자등록번호, 현금영수증 카드번호 ('-' 포함 가능)
+	IdentifierType receipt.IdentifierType // 식별 수단
+	Purpose        receipt.Purpose        // 용도, 비어있으면 식별 수단의 기본 용도
+
+	BuyerName  string
+	BuyerEmail string
+	BuyerTel   string
+	TaxFree    int32 // 면세공급가액
+}
+
+// Issue 계좌이체, 가상계좌 결제건에 대해 현금영수증을 발급한다.
+// 식별 번호는 '-' 와 공백을 제거해 전달한다.
+//
+// POST /receipts/{imp_uid}
+func (s *ReceiptService) Issue(impUID string, req CashReceipt) (*receipt.Receipt, error) {
+	if impUID == "" {
+		return nil, errors.New(ErrMustExistImpUID)
+	}
+
+	if req.Identifier == "" {
+		return nil, errors.New(ErrMustExistReceiptIdentifier)
+	}
+
+	if !req.IdentifierType.IsValid(req.Identifier) {
+		return nil, errors.New(ErrInvalidReceiptIdentifier)
+	}
+
+	purpose := req.Purpose
+	if purpose == "" {
+		purpose = req.IdentifierType.DefaultPurpose()
+	}
+
+	if !purpose.IsValid() {
+		return nil, errors.New(ErrInvalidReceiptPurpose)
+	}
+
+	if req.TaxFree < 0 {
+		return nil, errors.New(ErrInvalidReceiptTaxFree)
+	}
+
+	token, err := s.iamport.Authenticate.GetToken()
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := receipt.Issue(s.iamport.Authenticate.Client, s.iamport.Authenticate.APIUrl, token, &receipt.IssueRequest{
+		ImpUID:         impUID,
+		Identifier:     receipt.Normalize(req.Identifier),
+		IdentifierType: string(req.IdentifierType),
+		Type:           string(purpose),
+		BuyerName:      req.BuyerName,
+		BuyerEmail:     req.BuyerEmail,
+		BuyerTel:       req.BuyerTel,
+		TaxFree:        req.TaxFree,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if res.Code != util.CodeOK {
+		return nil, errors.New(res.Message)
+	}
+
+	return res.Response, nil
+}
+
+// Get 결제건에 발급된 현금영수증을 가져온다.
+//
+// GET /receipts/{imp_uid}
+func (s *ReceiptService) Get(impUID string) (*receipt.Receipt, error) {
+	return s.call(impUID, receipt.GetByImpUID)
+}
+
+// Cancel 결제건에 발급된 현금영수증을 취소한다.
+//
+// DELETE /receipts/{imp_uid}
+func (s *ReceiptService) Cancel(impUID string) (*receipt.Receipt, error) {
+	return s.call(impUID, receipt.Cancel)
+}
+
+type receiptCall func(*http.Client, string, string, *receipt.ReceiptRequest) (*receipt.ReceiptResponse, error)
+
+func (s *ReceiptService) call(impUID string, call receiptCall) (*receipt.Receipt, error) {
+	if impUID == "" {
+		return nil, errors.New(ErrMustExistImpUID)
+	}
+
+	token, err := s.iamport.Authenticate.GetToken()
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := call(s.iamport.Authenticate.Client, s.iamport.Authenticate.APIUrl, token, &receipt.ReceiptRequest{
+		ImpUID: impUID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if res.Code != util.CodeOK {
+		return nil, errors.New(res.Message)
+	}
+
+	return res.Response, nil
+}
@@ -0,0 +1,126 @@
+package iamport
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/iamport/go-iamport/contract"
+	"github.com/iamport/go-iamport/receipt"
+)
+
+const receiptJSON = `{
+	"imp_uid": "imp_1",
+	"receipt_tid": "receipt_1",
+	"apply_num": "123456789",
+	"type": "person",
+	"amount": 11000,
+	"vat": 1000,
+	"receipt_url": "https://example.com/receipt",
+	"applied_at": 1609459200,
+	"cancelled_at": 0
+}`
+
+func respondReceipt(w http.ResponseWriter, r *http.Request) {
+	contract.Respond(w, json.RawMessage(receiptJSON))
+}
+
+func TestReceiptIdentifier(t *testing.T) {
+	assert.True(t, receipt.IdentifierPhone.IsValid("010-1234-1234"))
+	assert.True(t, receipt.IdentifierPhone.IsValid("0111234123"))
+	assert.False(t, receipt.IdentifierPhone.IsValid("02-1234-1234"))
+	assert.False(t, receipt.IdentifierPhone.IsValid("010-1234-12345"))
+
+	assert.True(t, receipt.IdentifierBusiness.IsValid("124-81-00998"))
+	assert.False(t, receipt.IdentifierBusiness.IsValid("124-81-00999"))
+	assert.False(t, receipt.IdentifierBusiness.IsValid("124-81-0099"))
+
+	assert.True(t, receipt.IdentifierCard.IsValid("1234 5678 9012 3456"))
+	assert.False(t, receipt.IdentifierCard.IsValid("1234-5678-90"))
+	assert.False(t, receipt.IdentifierCard.IsValid("1234-5678-abcd-3456"))
+
+	assert.True(t, receipt.IdentifierPerson.IsValid("900101-1234567"))
+	assert.False(t, receipt.IdentifierPerson.IsValid("900101-123456"))
+	assert.False(t, receipt.IdentifierType("passport").IsValid("9001011234567"))
+
+	assert.Equal(t, receipt.PurposeBusiness, receipt.IdentifierBusiness.DefaultPurpose())
+	assert.Equal(t, receipt.PurposePersonal, receipt.IdentifierPhone.DefaultPurpose())
+}
+
+func TestReceiptsIssue(t *testing.T) {
+	iamport, server := newContractIamport(t, respondReceipt)
+
+	rec, err := iamport.Receipts.Issue("imp_1", CashReceipt{
+		Identifier:     "010-1234-1234",
+		IdentifierType: receipt.IdentifierPhone,
+		BuyerName:      "홍길동",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "123456789", rec.ApplyNum)
+	assert.Equal(t, receipt.PurposePersonal, rec.Type)
+	assert.Equal(t, int64(1609459200), rec.Applied().Unix())
+	assert.False(t, rec.IsCancelled())
+
+	req := server.LastRequest()
+	assert.Equal(t, http.MethodPost, req.Method)
+	assert.Equal(t, "/receipts/imp_1", req.Path)
+	assert.JSONEq(t, `{"identifier":"01012341234","identifier_type":"phone","type":"person","buyer_name":"홍길동"}`, string(req.Body))
+
+	// 사업자등록번호는 용도를 지정하지 않으면 지출증빙용으로 발급한다.
+	_, err = iamport.Receipts.Issue("imp_1", CashReceipt{
+		Identifier:     "124-81-00998",
+		IdentifierType: receipt.IdentifierBusiness,
+	})
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"identifier":"1248100998","identifier_type":"business","type":"company"}`, string(server.LastRequest().Body))
+}
+
+func TestReceiptsIssueInvalid(t *testing.T) {
+	iamport, server := newContractIamport(t, respondReceipt)
+
+	_, err := iamport.Receipts.Issue("", CashReceipt{})
+	assert.EqualError(t, err, ErrMustExistImpUID)
+
+	_, err = iamport.Receipts.Issue("imp_1", CashReceipt{IdentifierType: receipt.IdentifierPhone})
+	assert.EqualError(t, err, ErrMustExistReceiptIdentifier)
+
+	_, err = iamport.Receipts.Issue("imp_1", CashReceipt{Identifier: "124-81-00999", IdentifierType: receipt.IdentifierBusiness})
+	assert.EqualError(t, err, ErrInvalidReceiptIdentifier)
+
+	_, err = iamport.Receipts.Issue("imp_1", CashReceipt{Identifier: "01012341234", IdentifierType: receipt.IdentifierPhone, Purpose: "gift"})
+	assert.EqualError(t, err, ErrInvalidReceiptPurpose)
+
+	_, err = iamport.Receipts.Issue("imp_1", CashReceipt{Identifier: "01012341234", IdentifierType: receipt.IdentifierPhone, TaxFree: -1})
+	assert.EqualError(t, err, ErrInvalidReceiptTaxFree)
+
+	// 토큰 요청 외에는 API를 호출하지 않았다.
+	assert.Len(t, server.Requests(), 1)
+}
+
+func TestReceiptsGetAndCancel(t *testing.T) {
+	iamport, server := newContractIamport(t, respondReceipt)
+
+	rec, err := iamport.Receipts.Get("imp_1")
+	assert.NoError(t, err)
+	assert.Equal(t, "receipt_1", rec.ReceiptTID)
+	assert.Equal(t, http.MethodGet, server.LastRequest().Method)
+	assert.Equal(t, "/receipts/imp_1", server.LastRequest().Path)
+
+	_, err = iamport.Receipts.Cancel("imp_1")
+	assert.NoError(t, err)
+	assert.Equal(t, http.MethodDelete, server.LastRequest().Method)
+
+	_, err = iamport.Receipts.Cancel("")
+	assert.EqualError(t, err, ErrMustExistImpUID)
+}
+
+func TestReceiptsError(t *testing.T) {
+	iamport, _ := newContractIamport(t, func(w http.ResponseWriter, r *http.Request) {
+		contract.RespondError(w, "현금영수증 발급 대상 결제건이 아닙니다.")
+	})
+
+	_, err := iamport.Receipts.Get("imp_1")
+	assert.EqualError(t, err, "현금영수증 발급 대상 결제건이 아닙니다.")
+}
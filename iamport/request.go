@@ -0,0 +1,221 @@
+package iamport
+
+import (
+	"fmt"
+	"time"
+
+	TypeSubscribe "github.com/iamport/interface/gen_src/go/v1/subscribe"
+	TypeSubscribeCust "github.com/iamport/interface/gen_src/go/v1/subscribe_customers"
+
+	"github.com/iamport/go-iamport/util"
+	"github.com/iamport/go-iamport/validation"
+)
+
+const (
+	ParamTaxFree                = "tax_free"
+	ParamVatAmount              = "vat_amount"
+	ParamCardQuota              = "card_quota"
+	ParamInterestFreeByMerchant = "interest_free_by_merchant"
+	ParamExtra                  = "extra"
+)
+
+// Buyer 구매자(빌링키 등록시에는 고객) 정보
+type Buyer struct {
+	Name     string
+	Email    string
+	Tel      string
+	Addr     string
+	Postcode string
+}
+
+// Card 카드 정보
+type Card struct {
+	Number    string // 카드번호 (dddd-dddd-dddd-dddd)
+	Expiry    string // 카드 유효기간 (YYYY-MM)
+	Birth     string // 생년월일 6자리 (법인카드의 경우 사업자등록번호 10자리)
+	Pwd2Digit string // 카드비밀번호 앞 2자리
+}
+
+// Installment 할부 정보
+// 지정하면 Quota 가 0 이어도 card_quota 를 전송한다.
+type Installment struct {
+	Quota                  int32 // 할부개월수 (0 또는 1이면 일시불)
+	InterestFreeByMerchant bool  // 가맹점 부담 무이자 할부 여부
+}
+
+// Notice 결제 결과 통지 정보
+type Notice struct {
+	URL string // 결제성공 시 통지될 Notification URL (Webhook URL)
+}
+
+// OnetimePaymentRequest 비인증결제 요청
+//
+// 값을 보내지 않아야 하는 선택 항목은 포인터로 표현하며, nil이면 전송하지 않는다.
+type OnetimePaymentRequest struct {
+	MerchantUID string
+	Amount      int32
+	TaxFree     *int32 // 면세공급가액 (0을 지정하면 tax_free=0 을 전송)
+	VatAmount   *int32 // 부가세 (nil이면 아임포트가 자동 계산, 0을 지정하면 부가세 0원)
+	CustomerUID string // 빌링키를 저장할 customer_uid (없으면 저장하지 않음)
+	PG          string
+	Name        string
+	Card        Card
+	Buyer       Buyer
+	Installment *Installment
+	CustomData  string
+	Notice      Notice
+	Extra       map[string]string // PG사별 추가 파라미터 (extra[key]=value)
+}
+
+// AgainPaymentRequest 저장된 빌링키로 재결제 요청
+type AgainPaymentRequest struct {
+	CustomerUID string
+	MerchantUID string
+	Amount      int32
+	TaxFree     *int32
+	VatAmount   *int32
+	Name        string
+	Buyer       Buyer
+	Installment *Installment
+	CustomData  string
+	Notice      Notice
+	Extra       map[string]string
+}
+
+// BillingKeyRequest 빌링키 등록 요청
+type BillingKeyRequest struct {
+	CustomerUID string
+	PG          string
+	Card        Card
+	Customer    Buyer
+}
+
+// Validate 카드 정보를 검증한다. required가 false이면 카드번호가 없을 때 검증하지 않는다.
+func (c Card) Validate(required bool) validation.Errors {
+	return validation.Card(c.Number, c.Expiry, c.Birth, c.Pwd2Digit, required, time.Now())
+}
+
+// Validate 카드 정보와 금액을 검증한다.
+func (r *OnetimePaymentRequest) Validate() error {
+	errs := r.Card.Validate(true)
+	errs = append(errs, validation.Amount(r.Amount, int32Value(r.TaxFree), r.VatAmount)...)
+
+	return errs.Err()
+}
+
+// Validate 금액을 검증한다.
+func (r *AgainPaymentRequest) Validate() error {
+	return validation.Amount(r.Amount, int32Value(r.TaxFree), r.VatAmount).Err()
+}
+
+// Validate 카드 정보를 검증한다.
+func (r *BillingKeyRequest) Validate() error {
+	return r.Card.Validate(true).Err()
+}
+
+// validateSchedules 예약결제 요청의 카드 정보와 회차별 금액을 검증한다.
+func validateSchedules(card Card, schedules []*TypeSubscribe.PaymentScheduleParam) error {
+	errs := card.Validate(false)
+	for i, schedule := range schedules {
+		amountErrs := validation.Amount(schedule.GetAmount(), schedule.GetTaxFree(), nil)
+		errs = append(errs, amountErrs.Prefix(fmt.Sprintf("schedules[%d].", i))...)
+	}
+
+	return errs.Err()
+}
+
+// Int32 선택 항목에 값을 지정할 때 사용한다.
+func Int32(v int32) *int32 {
+	return &v
+}
+
+func int32Value(v *int32) int32 {
+	if v == nil {
+		return 0
+	}
+
+	return *v
+}
+
+func (r *OnetimePaymentRequest) proto() *TypeSubscribe.OnetimePaymentRequest {
+	req := &TypeSubscribe.OnetimePaymentRequest{
+		MerchantUid:   r.MerchantUID,
+		Amount:        r.Amount,
+		CardNumber:    r.Card.Number,
+		Expiry:        r.Card.Expiry,
+		Birth:         r.Card.Birth,
+		Pwd_2Digit:    r.Card.Pwd2Digit,
+		CustomerUid:   r.CustomerUID,
+		Pg:            r.PG,
+		Name:          r.Name,
+		BuyerName:     r.Buyer.Name,
+		BuyerEmail:    r.Buyer.Email,
+		BuyerTel:      r.Buyer.Tel,
+		BuyerAddr:     r.Buyer.Addr,
+		BuyerPostcode: r.Buyer.Postcode,
+		CustomData:    r.CustomData,
+		NoticeUrl:     r.Notice.URL,
+	}
+
+	return req
+}
+
+func (r *AgainPaymentRequest) proto() *TypeSubscribe.AgainPaymentRequest {
+	req := &TypeSubscribe.AgainPaymentRequest{
+		CustomerUid:   r.CustomerUID,
+		MerchantUid:   r.MerchantUID,
+		Amount:        r.Amount,
+		Name:          r.Name,
+		BuyerName:     r.Buyer.Name,
+		BuyerEmail:    r.Buyer.Email,
+		BuyerTel:      r.Buyer.Tel,
+		BuyerAddr:     r.Buyer.Addr,
+		BuyerPostcode: r.Buyer.Postcode,
+		CustomData:    r.CustomData,
+		NoticeUrl:     r.Notice.URL,
+	}
+
+	return req
+}
+
+func (r *BillingKeyRequest) proto() *TypeSubscribeCust.InsertCustomerBillingKeyRequest {
+	return &TypeSubscribeCust.InsertCustomerBillingKeyRequest{
+		CustomerUid:      r.CustomerUID,
+		Pg:               r.PG,
+		CardNumber:       r.Card.Number,
+		Expiry:           r.Card.Expiry,
+		Birth:            r.Card.Birth,
+		Pwd_2Digit:       r.Card.Pwd2Digit,
+		CustomerName:     r.Customer.Name,
+		CustomerTel:      r.Customer.Tel,
+		CustomerEmail:    r.Customer.Email,
+		CustomerAddr:     r.Customer.Addr,
+		CustomerPostcode: r.Customer.Postcode,
+	}
+}
+
+// extraParams proto 메시지로 보낼 수 없는 파라미터
+// proto 는 0 값을 생략하므로 지정된 tax_free, card_quota 는 0 이어도 보낼 수 있도록 여기서 전송하고,
+// proto 메시지에 정의되지 않은 vat_amount, extra 도 함께 전송한다.
+func extraParams(taxFree, vatAmount *int32, installment *Installment, extra map[string]string) util.Params {
+	params := util.Params{}
+
+	if taxFree != nil {
+		params[ParamTaxFree] = *taxFree
+	}
+
+	if vatAmount != nil {
+		params[ParamVatAmount] = *vatAmount
+	}
+
+	if installment != nil {
+		params[ParamCardQuota] = installment.Quota
+		params[ParamInterestFreeByMerchant] = installment.InterestFreeByMerchant
+	}
+
+	if len(extra) > 0 {
+		params[ParamExtra] = extra
+	}
+
+	return params
+}
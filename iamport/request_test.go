@@ -0,0 +1,175 @@
+package iamport
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	TypeSubscribe "github.com/iamport/interface/gen_src/go/v1/subscribe"
+
+	"github.com/iamport/go-iamport/validation"
+)
+
+func TestOnetimeSendsOptionalFieldsOnlyWhenSet(t *testing.T) {
+	iamport, server := newContractIamport(t, nil)
+
+	_, err := iamport.Subscribe.Onetime(&OnetimePaymentRequest{
+		MerchantUID: "merchant_onetime",
+		Amount:      1000,
+		Card: Card{
+			Number: "1111-2222-3333-4444",
+			Expiry: "2099-12",
+			Birth:  "911125",
+		},
+		Buyer: Buyer{Name: "홍길동", Tel: "010-1234-1234"},
+	})
+	assert.NoError(t, err)
+
+	form, err := url.ParseQuery(string(server.LastRequest().Body))
+	assert.NoError(t, err)
+	assert.Equal(t, "홍길동", form.Get("buyer_name"))
+	assert.Equal(t, "010-1234-1234", form.Get("buyer_tel"))
+	assert.NotContains(t, form, "vat_amount")
+	assert.NotContains(t, form, "card_quota")
+	assert.NotContains(t, form, "tax_free")
+}
+
+func TestOnetimeSendsExplicitZeroVatAmountAndExtra(t *testing.T) {
+	iamport, server := newContractIamport(t, nil)
+
+	_, err := iamport.Subscribe.Onetime(&OnetimePaymentRequest{
+		MerchantUID: "merchant_onetime",
+		Amount:      1000,
+		TaxFree:     Int32(1000),
+		VatAmount:   Int32(0),
+		Card:        Card{Number: "1111-2222-3333-4444", Expiry: "2099-12", Birth: "911125"},
+		Installment: &Installment{Quota: 3, InterestFreeByMerchant: true},
+		Extra:       map[string]string{"naverUseCfm": "20301231"},
+	})
+	assert.NoError(t, err)
+
+	form, err := url.ParseQuery(string(server.LastRequest().Body))
+	assert.NoError(t, err)
+	assert.Equal(t, "1000", form.Get("tax_free"))
+	assert.Equal(t, "0", form.Get("vat_amount"))
+	assert.Equal(t, "3", form.Get("card_quota"))
+	assert.Equal(t, "true", form.Get("interest_free_by_merchant"))
+	assert.Equal(t, "20301231", form.Get("extra[naverUseCfm]"))
+}
+
+func TestOnetimeSendsExplicitZeroTaxFreeAndQuota(t *testing.T) {
+	iamport, server := newContractIamport(t, nil)
+
+	_, err := iamport.Subscribe.Onetime(&OnetimePaymentRequest{
+		MerchantUID: "merchant_onetime",
+		Amount:      1000,
+		TaxFree:     Int32(0),
+		Card:        Card{Number: "1111-2222-3333-4444", Expiry: "2099-12", Birth: "911125"},
+		Installment: &Installment{},
+	})
+	assert.NoError(t, err)
+
+	form, err := url.ParseQuery(string(server.LastRequest().Body))
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"0"}, form["tax_free"])
+	assert.Equal(t, []string{"0"}, form["card_quota"])
+	assert.Equal(t, []string{"false"}, form["interest_free_by_merchant"])
+}
+
+func TestDeprecatedAgainPaymentOmitsZeroTaxFreeAndQuota(t *testing.T) {
+	iamport, server := newContractIamport(t, nil)
+
+	_, err := iamport.AgainPayment("customer_1234", "merchant_again", 1000, 0, "", "", "", "", "", "", 0, false, "", "")
+	assert.NoError(t, err)
+
+	form, err := url.ParseQuery(string(server.LastRequest().Body))
+	assert.NoError(t, err)
+	assert.NotContains(t, form, "tax_free")
+	assert.NotContains(t, form, "card_quota")
+	assert.NotContains(t, form, "interest_free_by_merchant")
+
+	_, err = iamport.AgainPayment("customer_1234", "merchant_again", 1000, 100, "", "", "", "", "", "", 3, false, "", "")
+	assert.NoError(t, err)
+
+	form, err = url.ParseQuery(string(server.LastRequest().Body))
+	assert.NoError(t, err)
+	assert.Equal(t, "100", form.Get("tax_free"))
+	assert.Equal(t, "3", form.Get("card_quota"))
+}
+
+func TestAgainRequiresCustomerAndMerchantUID(t *testing.T) {
+	iamport, _ := newContractIamport(t, nil)
+
+	payment, err := iamport.Subscribe.Again(&AgainPaymentRequest{MerchantUID: "merchant_again"})
+	assert.EqualError(t, err, ErrMustExistCustomerUID)
+	assert.Nil(t, payment)
+
+	payment, err = iamport.Subscribe.Again(&AgainPaymentRequest{CustomerUID: "customer_1234"})
+	assert.EqualError(t, err, ErrMustExistMerchantUID)
+	assert.Nil(t, payment)
+
+	payment, err = iamport.Subscribe.Again(nil)
+	assert.EqualError(t, err, ErrMustExistMerchantUID)
+	assert.Nil(t, payment)
+}
+
+func TestInsertMapsCustomerToCustomerFields(t *testing.T) {
+	iamport, server := newContractIamport(t, nil)
+
+	_, err := iamport.Customers.Insert(&BillingKeyRequest{
+		CustomerUID: "customer_1234",
+		Card:        Card{Number: "1111-2222-3333-4444", Expiry: "2099-12", Birth: "911125", Pwd2Digit: "11"},
+		Customer:    Buyer{Name: "홍길동", Email: "example@example.com"},
+	})
+	assert.NoError(t, err)
+
+	body := string(server.LastRequest().Body)
+	assert.Contains(t, body, `"customer_name":"홍길동"`)
+	assert.Contains(t, body, `"customer_email":"example@example.com"`)
+	assert.Contains(t, body, `"pwd_2digit":"11"`)
+}
+
+func TestOnetimeRejectsInvalidCardBeforeRequest(t *testing.T) {
+	iamport, server := newContractIamport(t, nil)
+
+	payment, err := iamport.Subscribe.Onetime(&OnetimePaymentRequest{
+		MerchantUID: "merchant_onetime",
+		Amount:      1000,
+		TaxFree:     Int32(2000),
+		Card:        Card{Number: "1111-2222-3333-4445", Expiry: "2020-01", Birth: "9111", Pwd2Digit: "1"},
+	})
+	assert.Nil(t, payment)
+	assert.Len(t, server.Requests(), 1)
+
+	errs, ok := err.(validation.Errors)
+	if !assert.True(t, ok) {
+		return
+	}
+	assert.Len(t, errs, 5)
+	assert.Equal(t, validation.MsgCardNumberChecksum, errs.Field(validation.FieldCardNumber).Message)
+	assert.Equal(t, validation.MsgExpiryPassed, errs.Field(validation.FieldExpiry).Message)
+	assert.Equal(t, validation.MsgBirthFormat, errs.Field(validation.FieldBirth).Message)
+	assert.Equal(t, validation.MsgPwd2DigitFormat, errs.Field(validation.FieldPwd2Digit).Message)
+	assert.Equal(t, validation.MsgTaxFreeExceeded, errs.Field(validation.FieldTaxFree).Message)
+}
+
+func TestScheduleValidatesCardOnlyWhenGiven(t *testing.T) {
+	iamport, server := newContractIamport(t, nil)
+
+	schedules := []*TypeSubscribe.PaymentScheduleParam{
+		{MerchantUid: "merchant_schedule_1", Amount: 1000},
+		{MerchantUid: "merchant_schedule_2", Amount: 1000, TaxFree: 1001},
+	}
+
+	_, err := iamport.Subscribe.Schedule("customer_1234", 0, "", "", "", "", "", schedules[:1])
+	assert.NoError(t, err)
+	assert.Len(t, server.Requests(), 2)
+
+	_, err = iamport.Subscribe.Schedule("customer_1234", 0, "", "", "", "", "", schedules)
+	errs, ok := err.(validation.Errors)
+	if assert.True(t, ok) {
+		assert.NotNil(t, errs.Field("schedules[1].tax_free"))
+	}
+	assert.Len(t, server.Requests(), 2)
+}
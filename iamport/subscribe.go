@@ -10,57 +10,34 @@ import (
 	"github.com/iamport/go-iamport/util"
 )
 
-// OnetimePayment ActiveX 없는 비인증결제
+// SubscribeService 비인증 결제와 예약 결제 API
+type SubscribeService service
+
+// Onetime ActiveX 없는 비인증결제
 //
 // POST /subscribe/payments/onetime
-func (iamport *Iamport) OnetimePayment(
-	merchantUID string,
-	amount, taxFree int32,
-	cardNumber, expiry, birth, pwd_2digit string,
-	customerUid, pg, name string,
-	buyerName, buyerEmail, buyerTel, buyerAddr, buyerPostcode string,
-	cardQuota int32, interestFreeByMerchant bool,
-	customData, noticeUrl string,
-) (*Typepayment.Payment, error) {
-
-	if merchantUID == "" {
+func (s *SubscribeService) Onetime(params *OnetimePaymentRequest) (*Typepayment.Payment, error) {
+	if params == nil || params.MerchantUID == "" {
 		return nil, errors.New(ErrMustExistMerchantUID)
 	}
 
-	if amount < 0 {
+	if params.Amount < 0 {
 		return nil, errors.New(ErrInvalidAmount)
 	}
 
-	token, err := iamport.Authenticate.GetToken()
+	err := params.Validate()
 	if err != nil {
 		return nil, err
 	}
 
-	req := &TypeSubscribe.OnetimePaymentRequest{
-		MerchantUid:            merchantUID,
-		Amount:                 amount,
-		TaxFree:                taxFree,
-		CardNumber:             cardNumber,
-		Expiry:                 expiry,
-		Birth:                  birth,
-		Pwd_2Digit:             pwd_2digit,
-		CustomerUid:            customerUid,
-		Pg:                     pg,
-		Name:                   name,
-		BuyerName:              buyerName,
-		BuyerEmail:             buyerEmail,
-		BuyerTel:               buyerTel,
-		BuyerAddr:              buyerAddr,
-		BuyerPostcode:          buyerPostcode,
-		CardQuota:              cardQuota,
-		InterestFreeByMerchant: interestFreeByMerchant,
-		CustomData:             customData,
-		NoticeUrl:              noticeUrl,
+	token, err := s.iamport.Authenticate.GetToken()
+	if err != nil {
+		return nil, err
 	}
 
 	res, err := subscribe.Onetime(
-		iamport.Authenticate.Client, iamport.Authenticate.APIUrl,
-		token, req,
+		s.iamport.Authenticate.Client, s.iamport.Authenticate.APIUrl,
+		token, params.proto(), extraParams(params.TaxFree, params.VatAmount, params.Installment, params.Extra),
 	)
 
 	if err != nil {
@@ -74,51 +51,35 @@ func (iamport *Iamport) OnetimePayment(
 	return res.Response, nil
 }
 
-// AgainPayment 이전 결제 데이터를 이용한 재결제
+// Again 이전 결제 데이터를 이용한 재결제
 //
 // POST /subscribe/payments/again
-func (iamport *Iamport) AgainPayment(
-	customerUID, merchantUID string,
-	amount, taxFree int32,
-	name string,
-	buyerName, buyerEmail, buyerTel, buyerAddr, buyerPostcode string,
-	cardQuota int32, interestFreeByMerchant bool,
-	customData, noticeUrl string,
-) (*Typepayment.Payment, error) {
+func (s *SubscribeService) Again(params *AgainPaymentRequest) (*Typepayment.Payment, error) {
+	if params == nil || params.MerchantUID == "" {
+		return nil, errors.New(ErrMustExistMerchantUID)
+	}
 
-	if merchantUID == "" || customerUID == "" {
-		return nil, errors.New(ErrMustExistImpUIDorMerchantUID)
+	if params.CustomerUID == "" {
+		return nil, errors.New(ErrMustExistCustomerUID)
 	}
 
-	if amount < 0 {
+	if params.Amount < 0 {
 		return nil, errors.New(ErrInvalidAmount)
 	}
 
-	token, err := iamport.Authenticate.GetToken()
+	err := params.Validate()
 	if err != nil {
 		return nil, err
 	}
 
-	req := &TypeSubscribe.AgainPaymentRequest{
-		CustomerUid:            customerUID,
-		MerchantUid:            merchantUID,
-		Amount:                 amount,
-		TaxFree:                taxFree,
-		Name:                   name,
-		BuyerName:              buyerName,
-		BuyerEmail:             buyerEmail,
-		BuyerTel:               buyerTel,
-		BuyerAddr:              buyerAddr,
-		BuyerPostcode:          buyerPostcode,
-		CardQuota:              cardQuota,
-		InterestFreeByMerchant: interestFreeByMerchant,
-		CustomData:             customData,
-		NoticeUrl:              noticeUrl,
+	token, err := s.iamport.Authenticate.GetToken()
+	if err != nil {
+		return nil, err
 	}
 
 	res, err := subscribe.Again(
-		iamport.Authenticate.Client, iamport.Authenticate.APIUrl,
-		token, req,
+		s.iamport.Authenticate.Client, s.iamport.Authenticate.APIUrl,
+		token, params.proto(), extraParams(params.TaxFree, params.VatAmount, params.Installment, params.Extra),
 	)
 	if err != nil {
 		return nil, err
@@ -131,10 +92,10 @@ func (iamport *Iamport) AgainPayment(
 	return res.Response, nil
 }
 
-// SchedulePayment 예약결제
+// Schedule 예약결제
 //
 // POST /subscribe/payments/schedule
-func (iamport *Iamport) SchedulePayment(
+func (s *SubscribeService) Schedule(
 	customerUID string, checkingAmount int32,
 	cardNumber, expiry, birth, pwd2Digit, pg string,
 	schedules []*TypeSubscribe.PaymentScheduleParam,
@@ -144,7 +105,12 @@ func (iamport *Iamport) SchedulePayment(
 		return nil, errors.New(ErrMustExistCustomerUID)
 	}
 
-	token, err := iamport.Authenticate.GetToken()
+	err := validateSchedules(Card{Number: cardNumber, Expiry: expiry, Birth: birth, Pwd2Digit: pwd2Digit}, schedules)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := s.iamport.Authenticate.GetToken()
 	if err != nil {
 		return nil, err
 	}
@@ -161,7 +127,7 @@ func (iamport *Iamport) SchedulePayment(
 	}
 
 	res, err := subscribe.Schedule(
-		iamport.Authenticate.Client, iamport.Authenticate.APIUrl,
+		s.iamport.Authenticate.Client, s.iamport.Authenticate.APIUrl,
 		token, req,
 	)
 
@@ -176,15 +142,15 @@ func (iamport *Iamport) SchedulePayment(
 	return res.Response, nil
 }
 
-// UnschedulePayment 예약결제 취소
+// Unschedule 예약결제 취소
 //
 // POST /subscribe/payments/unschedule
-func (iamport *Iamport) UnschedulePayment(customerUID string, merchantUID []string) ([]*TypeSubscribe.UnitSchedulePaymentResponse, error) {
+func (s *SubscribeService) Unschedule(customerUID string, merchantUID []string) ([]*TypeSubscribe.UnitSchedulePaymentResponse, error) {
 	if customerUID == "" {
 		return nil, errors.New(ErrMustExistCustomerUID)
 	}
 
-	token, err := iamport.Authenticate.GetToken()
+	token, err := s.iamport.Authenticate.GetToken()
 	if err != nil {
 		return nil, err
 	}
@@ -195,7 +161,7 @@ func (iamport *Iamport) UnschedulePayment(customerUID string, merchantUID []stri
 	}
 
 	res, err := subscribe.Unschedule(
-		iamport.Authenticate.Client, iamport.Authenticate.APIUrl,
+		s.iamport.Authenticate.Client, s.iamport.Authenticate.APIUrl,
 		token, req,
 	)
 	if err != nil {
@@ -209,15 +175,15 @@ func (iamport *Iamport) UnschedulePayment(customerUID string, merchantUID []stri
 	return res.Response, nil
 }
 
-// GetScheduledPaymentByMerchantUID Merchant UID별로 예약결제내역을 가져오는 API
+// GetSchedule Merchant UID별로 예약결제내역을 가져오는 API
 //
 // GET /subscribe/payments/schedule/{merchant_uid}
-func (iamport *Iamport) GetScheduledPaymentByMerchantUID(merchantUID string) (*TypeSubscribe.UnitSchedulePaymentResponse, error) {
+func (s *SubscribeService) GetSchedule(merchantUID string) (*TypeSubscribe.UnitSchedulePaymentResponse, error) {
 	if merchantUID == "" {
 		return nil, errors.New(ErrMustExistMerchantUID)
 	}
 
-	token, err := iamport.Authenticate.GetToken()
+	token, err := s.iamport.Authenticate.GetToken()
 	if err != nil {
 		return nil, err
 	}
@@ -227,7 +193,7 @@ func (iamport *Iamport) GetScheduledPaymentByMerchantUID(merchantUID string) (*T
 	}
 
 	res, err := subscribe.GetScheduledPaymentByMerchantUID(
-		iamport.Authenticate.Client, iamport.Authenticate.APIUrl,
+		s.iamport.Authenticate.Client, s.iamport.Authenticate.APIUrl,
 		token, req,
 	)
 
@@ -242,24 +208,28 @@ func (iamport *Iamport) GetScheduledPaymentByMerchantUID(merchantUID string) (*T
 	return res.Response, nil
 }
 
-// GetScheduledPaymentByCustomerUID Customer UID별로 예약결제내역을 가져오는 API
+// GetSchedulesByCustomer Customer UID별로 예약결제내역을 가져오는 API
 //
-// GET /subscribe/payments/schedule/customer/{customer_uid}
-func (iamport *Iamport) GetScheduledPaymentByCustomerUID(
+// GET /subscribe/payments/schedule/customers/{customer_uid}
+func (s *SubscribeService) GetSchedulesByCustomer(
 	customerUID string,
 	page, from, to int32,
-	scheduleStatus string,
+	scheduleStatus util.ScheduleStatus,
 ) (*TypeSubscribe.NestedGetPaymentScheduleByCustomerData, error) {
 	if customerUID == "" {
 		return nil, errors.New(ErrMustExistCustomerUID)
 	}
 
+	if scheduleStatus != "" && !scheduleStatus.IsValid() {
+		return nil, errors.New(ErrInvalidScheduleStatusParam)
+	}
+
 	var revisedPage int32 = 1
 	if page != 0 {
 		revisedPage = page
 	}
 
-	token, err := iamport.Authenticate.GetToken()
+	token, err := s.iamport.Authenticate.GetToken()
 	if err != nil {
 		return nil, err
 	}
@@ -269,11 +239,11 @@ func (iamport *Iamport) GetScheduledPaymentByCustomerUID(
 		Page:           revisedPage,
 		From:           from,
 		To:             to,
-		ScheduleStatus: scheduleStatus,
+		ScheduleStatus: scheduleStatus.String(),
 	}
 
 	res, err := subscribe.GetScheduledPaymentByCustomerUID(
-		iamport.Authenticate.Client, iamport.Authenticate.APIUrl,
+		s.iamport.Authenticate.Client, s.iamport.Authenticate.APIUrl,
 		token, req,
 	)
 	if err != nil {
@@ -0,0 +1,199 @@
+package iamport
+
+import (
+	"errors"
+	"time"
+
+	TypePayment "github.com/iamport/interface/gen_src/go/v1/payment"
+
+	"github.com/iamport/go-iamport/util"
+	"github.com/iamport/go-iamport/vbank"
+)
+
+const (
+	ErrInvalidBank          = "iamport: bank code is invalid"
+	ErrMustExistVbankDue    = "iamport: vbank due must be exist"
+	ErrInvalidVbankDue      = "iamport: vbank due must be in the future"
+	ErrMustExistVbankHolder = "iamport: vbank holder must be exist"
+	ErrMustExistVbankChange = "iamport: amount or vbank due must be exist"
+)
+
+// VbankService 가상계좌 API
+type VbankService service
+
+// VbankIssue 가상계좌 발급 정보
+type VbankIssue struct {
+	MerchantUID string
+	Amount      int32
+	Bank        vbank.Bank // 가상계좌 은행
+	Due         time.Time  // 입금기한, 날짜 단위라면 vbank.EndOfDay 를 사용한다.
+	Holder      string     // 예금주
+
+	Name          string
+	BuyerName     string
+	BuyerEmail    string
+	BuyerTel      string
+	BuyerAddr     string
+	BuyerPostcode string
+	PG            string // 여러 PG사를 사용하는 경우 PG사 구분
+	NoticeURL     string // 입금 통지를 받을 웹훅 URL
+	CustomData    string
+}
+
+// Issue 가상계좌를 발급한다. 발급된 결제건은 ready 상태이며 입금되면 paid 가 된다.
+//
+// POST /vbanks
+func (s *VbankService) Issue(issue VbankIssue) (*TypePayment.Payment, error) {
+	if issue.MerchantUID == "" {
+		return nil, errors.New(ErrMustExistMerchantUID)
+	}
+
+	if issue.Amount <= 0 {
+		return nil, errors.New(ErrInvalidAmount)
+	}
+
+	if !issue.Bank.IsValid() {
+		return nil, errors.New(ErrInvalidBank)
+	}
+
+	if err := validateVbankDue(issue.Due); err != nil {
+		return nil, err
+	}
+
+	if issue.Holder == "" {
+		return nil, errors.New(ErrMustExistVbankHolder)
+	}
+
+	token, err := s.iamport.Authenticate.GetToken()
+	if err != nil {
+		return nil, err
+	}
+
+	req := &vbank.IssueRequest{
+		MerchantUID:   issue.MerchantUID,
+		Amount:        issue.Amount,
+		VbankCode:     issue.Bank.String(),
+		VbankDue:      int32(issue.Due.Unix()),
+		VbankHolder:   issue.Holder,
+		Name:          issue.Name,
+		BuyerName:     issue.BuyerName,
+		BuyerEmail:    issue.BuyerEmail,
+		BuyerTel:      issue.BuyerTel,
+		BuyerAddr:     issue.BuyerAddr,
+		BuyerPostcode: issue.BuyerPostcode,
+		Pg:            issue.PG,
+		NoticeURL:     issue.NoticeURL,
+		CustomData:    issue.CustomData,
+	}
+
+	res, err := vbank.Issue(s.iamport.Authenticate.Client, s.iamport.Authenticate.APIUrl, token, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.Code != util.CodeOK {
+		return nil, errors.New(res.Message)
+	}
+
+	return res.Response, nil
+}
+
+// Edit 입금 전인 가상계좌의 결제금액 또는 입금기한을 변경한다.
+// amount 가 0이거나 due 가 비어있으면 해당 값은 바꾸지 않는다.
+//
+// PUT /vbanks/{imp_uid}
+func (s *VbankService) Edit(impUID string, amount int32, due time.Time) (*TypePayment.Payment, error) {
+	if impUID == "" {
+		return nil, errors.New(ErrMustExistImpUID)
+	}
+
+	if amount < 0 {
+		return nil, errors.New(ErrInvalidAmount)
+	}
+
+	if amount == 0 && due.IsZero() {
+		return nil, errors.New(ErrMustExistVbankChange)
+	}
+
+	req := &vbank.EditRequest{
+		ImpUID: impUID,
+		Amount: amount,
+	}
+
+	if !due.IsZero() {
+		if err := validateVbankDue(due); err != nil {
+			return nil, err
+		}
+		req.VbankDue = int32(due.Unix())
+	}
+
+	token, err := s.iamport.Authenticate.GetToken()
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := vbank.Edit(s.iamport.Authenticate.Client, s.iamport.Authenticate.APIUrl, token, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.Code != util.CodeOK {
+		return nil, errors.New(res.Message)
+	}
+
+	return res.Response, nil
+}
+
+// Delete 입금 전인 가상계좌의 발급을 취소한다.
+//
+// DELETE /vbanks/{imp_uid}
+func (s *VbankService) Delete(impUID string) (*TypePayment.Payment, error) {
+	if impUID == "" {
+		return nil, errors.New(ErrMustExistImpUID)
+	}
+
+	token, err := s.iamport.Authenticate.GetToken()
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := vbank.Delete(s.iamport.Authenticate.Client, s.iamport.Authenticate.APIUrl, token, &vbank.DeleteRequest{
+		ImpUID: impUID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if res.Code != util.CodeOK {
+		return nil, errors.New(res.Message)
+	}
+
+	return res.Response, nil
+}
+
+// VbankDue 결제건의 가상계좌 입금기한. 가상계좌 결제건이 아니면 빈 time.Time
+func VbankDue(pay *TypePayment.Payment) time.Time {
+	if pay.GetVbankDate() == 0 {
+		return time.Time{}
+	}
+
+	return time.Unix(int64(pay.GetVbankDate()), 0)
+}
+
+// IsVbankExpired 입금 전인 가상계좌의 입금기한이 now 기준으로 지났는지 확인한다.
+func IsVbankExpired(pay *TypePayment.Payment, now time.Time) bool {
+	due := VbankDue(pay)
+	return !due.IsZero() && util.PaymentStatus(pay.GetStatus()).IsReady() && now.After(due)
+}
+
+func validateVbankDue(due time.Time) error {
+	if due.IsZero() {
+		return errors.New(ErrMustExistVbankDue)
+	}
+
+	if !due.After(time.Now()) {
+		return errors.New(ErrInvalidVbankDue)
+	}
+
+	return nil
+}
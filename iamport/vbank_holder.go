@@ -0,0 +1,129 @@
+package iamport
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+
+	TypePayment "github.com/iamport/interface/gen_src/go/v1/payment"
+
+	"github.com/iamport/go-iamport/util"
+	"github.com/iamport/go-iamport/vbank"
+)
+
+const (
+	ErrMustExistBankNum       = "iamport: bank account number must be exist"
+	ErrMustExistRefundAccount = "iamport: refund holder, bank and account must be exist"
+)
+
+// HolderMismatchError 환불 계좌의 실제 예금주가 요청한 예금주와 다른 경우
+type HolderMismatchError struct {
+	Bank     string
+	Account  string
+	Expected string // 요청한 예금주
+	Actual   string // 은행에 등록된 예금주
+}
+
+func (e *HolderMismatchError) Error() string {
+	return fmt.Sprintf("iamport: refund account holder mismatch: expected %s, actual %s", e.Expected, e.Actual)
+}
+
+// Holder 은행 코드와 계좌번호로 예금주를 조회한다. 계좌번호의 '-' 와 공백은 제거하고 조회한다.
+//
+// GET /vbanks/holder
+func (s *VbankService) Holder(bank vbank.Bank, account string) (string, error) {
+	if !bank.IsValid() {
+		return "", errors.New(ErrInvalidBank)
+	}
+
+	account = normalizeAccount(account)
+	if account == "" {
+		return "", errors.New(ErrMustExistBankNum)
+	}
+
+	token, err := s.iamport.Authenticate.GetToken()
+	if err != nil {
+		return "", err
+	}
+
+	res, err := vbank.GetHolder(s.iamport.Authenticate.Client, s.iamport.Authenticate.APIUrl, token, &vbank.HolderRequest{
+		BankCode: bank.String(),
+		BankNum:  account,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	if res.Code != util.CodeOK {
+		return "", errors.New(res.Message)
+	}
+
+	if res.Response == nil {
+		return "", nil
+	}
+
+	return res.Response.BankHolder, nil
+}
+
+// VerifyRefundAccount 환불 계좌의 예금주가 account.Holder 와 같은지 확인한다.
+// 공백과 영문 대소문자는 구분하지 않으며, 다르면 *HolderMismatchError 를 return 해준다.
+//
+// GET /vbanks/holder
+func (s *VbankService) VerifyRefundAccount(account RefundAccount) error {
+	if account.Holder == "" || account.Bank == "" || account.Account == "" {
+		return errors.New(ErrMustExistRefundAccount)
+	}
+
+	holder, err := s.Holder(vbank.Bank(account.Bank), account.Account)
+	if err != nil {
+		return err
+	}
+
+	if !sameHolder(holder, account.Holder) {
+		return &HolderMismatchError{
+			Bank:     account.Bank,
+			Account:  account.Account,
+			Expected: account.Holder,
+			Actual:   holder,
+		}
+	}
+
+	return nil
+}
+
+// RefundToVerifiedAccount 환불 계좌의 예금주를 확인한 뒤 PartialCancel 로 취소한다.
+// 가상계좌 결제건은 환불 계좌가 잘못되면 취소 후 며칠 뒤에야 환불이 반송되므로 미리 확인한다.
+// 예금주가 다르면 취소 요청을 보내지 않고 *HolderMismatchError 를 return 해준다.
+// 환불 계좌번호는 예금주를 확인한 형식 그대로('-' 와 공백 제거) 전송한다.
+//
+// GET /vbanks/holder, GET /payments/{imp_uid}, POST /payments/cancel
+func (s *PaymentService) RefundToVerifiedAccount(impUID string, req PartialCancelRequest) (*TypePayment.Payment, error) {
+	if impUID == "" {
+		return nil, errors.New(ErrMustExistImpUID)
+	}
+
+	req.Refund.Account = normalizeAccount(req.Refund.Account)
+	if err := s.iamport.Vbanks.VerifyRefundAccount(req.Refund); err != nil {
+		return nil, err
+	}
+
+	return s.PartialCancel(impUID, req)
+}
+
+// normalizeAccount 계좌번호의 '-' 와 공백을 제거한다.
+func normalizeAccount(account string) string {
+	return strings.NewReplacer("-", "", " ", "").Replace(account)
+}
+
+// sameHolder 공백을 제거하고 대소문자 구분 없이 비교한다.
+func sameHolder(a, b string) bool {
+	strip := func(r rune) rune {
+		if unicode.IsSpace(r) {
+			return -1
+		}
+		return r
+	}
+
+	return strings.EqualFold(strings.Map(strip, a), strings.Map(strip, b))
+}
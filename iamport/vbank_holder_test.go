@@ -0,0 +1,76 @@
+package iamport
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/iamport/go-iamport/contract"
+	"github.com/iamport/go-iamport/vbank"
+)
+
+// holderServer 예금주 조회는 holder 로 응답하고 나머지 요청은 cancelServer 가 처리한다.
+func holderServer(holder string, cancels *cancelServer) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/vbanks/holder" {
+			contract.Respond(w, &vbank.Holder{BankHolder: holder})
+			return
+		}
+
+		cancels.handle(w, r)
+	}
+}
+
+func TestVbanksHolder(t *testing.T) {
+	iamport, server := newContractIamport(t, holderServer("홍길동", newCancelServer()))
+
+	holder, err := iamport.Vbanks.Holder(vbank.BankKB, "799-5907-8731512")
+	assert.NoError(t, err)
+	assert.Equal(t, "홍길동", holder)
+	assert.Equal(t, "bank_code=004&bank_num=79959078731512", server.LastRequest().RawQuery)
+
+	_, err = iamport.Vbanks.Holder("999", "79959078731512")
+	assert.EqualError(t, err, ErrInvalidBank)
+	_, err = iamport.Vbanks.Holder(vbank.BankKB, " - ")
+	assert.EqualError(t, err, ErrMustExistBankNum)
+}
+
+func TestVbanksVerifyRefundAccount(t *testing.T) {
+	iamport, _ := newContractIamport(t, holderServer("John Doe", newCancelServer()))
+
+	assert.NoError(t, iamport.Vbanks.VerifyRefundAccount(RefundAccount{Holder: "john doe", Bank: "004", Account: "79959078731512"}))
+
+	err := iamport.Vbanks.VerifyRefundAccount(RefundAccount{Holder: "Jane Doe", Bank: "004", Account: "79959078731512"})
+	var mismatch *HolderMismatchError
+	assert.True(t, errors.As(err, &mismatch))
+	assert.Equal(t, "John Doe", mismatch.Actual)
+
+	err = iamport.Vbanks.VerifyRefundAccount(RefundAccount{Bank: "004", Account: "79959078731512"})
+	assert.EqualError(t, err, ErrMustExistRefundAccount)
+}
+
+func TestPaymentsRefundToVerifiedAccount(t *testing.T) {
+	cancels := newCancelServer()
+	iamport, server := newContractIamport(t, holderServer("홍길동", cancels))
+
+	refund := RefundAccount{Holder: "홍 길동", Bank: "004", Account: "799590-78-731512"}
+	pay, err := iamport.Payments.RefundToVerifiedAccount("imp_1", PartialCancelRequest{Amount: 400, Refund: refund})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(600), Cancellable(pay))
+
+	last := server.LastRequest()
+	assert.Equal(t, "/payments/cancel", last.Path)
+	// 예금주를 확인한 계좌번호와 같은 형식으로 환불한다.
+	assert.Contains(t, string(last.Body), "refund_account=79959078731512&")
+	assert.Equal(t, "bank_code=004&bank_num=79959078731512", server.Requests()[1].RawQuery)
+
+	// 예금주가 다르면 취소하지 않는다.
+	server.Reset()
+	refund.Holder = "김철수"
+	_, err = iamport.Payments.RefundToVerifiedAccount("imp_1", PartialCancelRequest{Amount: 100, Refund: refund})
+	assert.IsType(t, &HolderMismatchError{}, err)
+	assert.Equal(t, "/vbanks/holder", server.LastRequest().Path)
+	assert.Equal(t, int32(600), Cancellable(cancels.pay))
+}
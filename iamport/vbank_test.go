@@ -0,0 +1,134 @@
+package iamport
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	TypePayment "github.com/iamport/interface/gen_src/go/v1/payment"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/iamport/go-iamport/contract"
+	"github.com/iamport/go-iamport/util"
+	"github.com/iamport/go-iamport/vbank"
+)
+
+func vbankPayment(w http.ResponseWriter, r *http.Request) {
+	contract.Respond(w, &TypePayment.Payment{
+		ImpUid:      "imp_1",
+		Status:      string(util.StatusReady),
+		PayMethod:   util.PayMethodVbank,
+		VbankCode:   string(vbank.BankShinhan),
+		VbankNum:    "56211105948400",
+		VbankHolder: "아임포트",
+		VbankDate:   1893455999,
+	})
+}
+
+func TestVbanksIssue(t *testing.T) {
+	iamport, server := newContractIamport(t, vbankPayment)
+
+	due := vbank.EndOfDay(time.Now().AddDate(0, 0, 3))
+	pay, err := iamport.Vbanks.Issue(VbankIssue{
+		MerchantUID: "order_1",
+		Amount:      50000,
+		Bank:        vbank.BankShinhan,
+		Due:         due,
+		Holder:      "아임포트",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "56211105948400", pay.GetVbankNum())
+	assert.Equal(t, time.Unix(1893455999, 0), VbankDue(pay))
+
+	req := server.LastRequest()
+	assert.Equal(t, "POST", req.Method)
+	assert.Equal(t, "/vbanks", req.Path)
+
+	var body map[string]interface{}
+	assert.NoError(t, json.Unmarshal(req.Body, &body))
+	assert.Equal(t, map[string]interface{}{
+		"merchant_uid": "order_1",
+		"amount":       float64(50000),
+		"vbank_code":   "088",
+		"vbank_due":    float64(due.Unix()),
+		"vbank_holder": "아임포트",
+	}, body)
+}
+
+func TestVbanksIssueValidation(t *testing.T) {
+	iamport, server := newContractIamport(t, vbankPayment)
+
+	valid := VbankIssue{MerchantUID: "order_1", Amount: 1000, Bank: vbank.BankKB, Due: time.Now().Add(time.Hour), Holder: "아임포트"}
+	invalid := func(modify func(*VbankIssue)) VbankIssue {
+		issue := valid
+		modify(&issue)
+		return issue
+	}
+
+	for err, issue := range map[string]VbankIssue{
+		ErrMustExistMerchantUID: invalid(func(i *VbankIssue) { i.MerchantUID = "" }),
+		ErrInvalidAmount:        invalid(func(i *VbankIssue) { i.Amount = 0 }),
+		ErrInvalidBank:          invalid(func(i *VbankIssue) { i.Bank = "999" }),
+		ErrMustExistVbankDue:    invalid(func(i *VbankIssue) { i.Due = time.Time{} }),
+		ErrInvalidVbankDue:      invalid(func(i *VbankIssue) { i.Due = time.Now().Add(-time.Minute) }),
+		ErrMustExistVbankHolder: invalid(func(i *VbankIssue) { i.Holder = "" }),
+	} {
+		_, got := iamport.Vbanks.Issue(issue)
+		assert.EqualError(t, got, err)
+	}
+
+	// 토큰 발급 외에는 요청하지 않는다.
+	assert.Len(t, server.Requests(), 1)
+}
+
+func TestVbanksEdit(t *testing.T) {
+	iamport, server := newContractIamport(t, vbankPayment)
+
+	_, err := iamport.Vbanks.Edit("imp_1", 45000, time.Time{})
+	assert.NoError(t, err)
+
+	req := server.LastRequest()
+	assert.Equal(t, "PUT", req.Method)
+	assert.Equal(t, "/vbanks/imp_1", req.Path)
+	assert.JSONEq(t, `{"amount":45000}`, string(req.Body))
+
+	_, err = iamport.Vbanks.Edit("imp_1", 0, time.Time{})
+	assert.EqualError(t, err, ErrMustExistVbankChange)
+	_, err = iamport.Vbanks.Edit("imp_1", 0, time.Now().Add(-time.Hour))
+	assert.EqualError(t, err, ErrInvalidVbankDue)
+	_, err = iamport.Vbanks.Edit("", 1000, time.Time{})
+	assert.EqualError(t, err, ErrMustExistImpUID)
+}
+
+func TestVbanksDelete(t *testing.T) {
+	iamport, server := newContractIamport(t, func(w http.ResponseWriter, r *http.Request) {
+		contract.RespondError(w, "이미 입금된 가상계좌입니다.")
+	})
+
+	pay, err := iamport.Vbanks.Delete("imp_1")
+	assert.Nil(t, pay)
+	assert.EqualError(t, err, "이미 입금된 가상계좌입니다.")
+	assert.Equal(t, "DELETE", server.LastRequest().Method)
+}
+
+func TestIsVbankExpired(t *testing.T) {
+	due := time.Date(2021, 3, 1, 23, 59, 59, 0, time.UTC)
+	pay := &TypePayment.Payment{Status: string(util.StatusReady), VbankDate: int32(due.Unix())}
+
+	assert.False(t, IsVbankExpired(pay, due))
+	assert.True(t, IsVbankExpired(pay, due.Add(time.Second)))
+
+	pay.Status = string(util.StatusPaid)
+	assert.False(t, IsVbankExpired(pay, due.Add(time.Second)))
+	assert.False(t, IsVbankExpired(&TypePayment.Payment{Status: string(util.StatusReady)}, due))
+}
+
+func TestVbankBankAndEndOfDay(t *testing.T) {
+	assert.Equal(t, "신한은행", vbank.BankShinhan.Name())
+	assert.False(t, vbank.Bank("999").IsValid())
+
+	// UTC 2021-03-01 16:00 은 KST 3월 2일 01:00
+	end := vbank.EndOfDay(time.Date(2021, 3, 1, 16, 0, 0, 0, time.UTC))
+	assert.Equal(t, "2021-03-02 23:59:59 +0900", end.Format("2006-01-02 15:04:05 -0700"))
+}
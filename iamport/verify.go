@@ -0,0 +1,132 @@
+package iamport
+
+import (
+	"errors"
+	"strconv"
+
+	TypePayment "github.com/iamport/interface/gen_src/go/v1/payment"
+
+	"github.com/iamport/go-iamport/util"
+)
+
+const (
+	VerifyFieldMerchantUID  = "merchant_uid"
+	VerifyFieldAmount       = "amount"
+	VerifyFieldCurrency     = "currency"
+	VerifyFieldStatus       = "status"
+	VerifyFieldCancelAmount = "cancel_amount"
+)
+
+// Expectation 결제 검증 기준 (가맹점 주문 정보)
+type Expectation struct {
+	MerchantUID string // 주문번호
+	Amount      int32  // 결제되어야 하는 금액
+	Currency    string // 비어있으면 KRW
+}
+
+// VerdictResult 결제 검증 결과
+type VerdictResult string
+
+const (
+	VerdictPaid            VerdictResult = "paid"             // 주문 정보와 일치하며 결제완료
+	VerdictAwaitingDeposit VerdictResult = "awaiting_deposit" // 주문 정보와 일치하며 가상계좌 입금 대기
+	VerdictRejected        VerdictResult = "rejected"         // 주문 정보와 다르거나 결제되지 않음
+)
+
+// Mismatch 주문 정보와 다른 항목
+type Mismatch struct {
+	Field    string
+	Expected string
+	Actual   string
+}
+
+// Verdict 결제 검증 결과
+type Verdict struct {
+	Result     VerdictResult
+	Payment    *TypePayment.Payment
+	Mismatches []*Mismatch
+}
+
+// OK 결제완료 되었으며 주문 정보와 일치하는지 확인한다.
+func (v *Verdict) OK() bool {
+	return v.Result == VerdictPaid
+}
+
+// AwaitingDeposit 가상계좌가 발급되어 입금을 기다리는 중인지 확인한다.
+func (v *Verdict) AwaitingDeposit() bool {
+	return v.Result == VerdictAwaitingDeposit
+}
+
+// Mismatch 해당 항목의 불일치 정보. 일치하면 nil
+func (v *Verdict) Mismatch(field string) *Mismatch {
+	for _, mismatch := range v.Mismatches {
+		if mismatch.Field == field {
+			return mismatch
+		}
+	}
+
+	return nil
+}
+
+// Verify imp_uid 로 결제 정보를 가져와 주문 정보와 비교한다.
+// 결제 정보를 가져오지 못한 경우에만 error 를 return 하며, 불일치 항목은 Verdict 에 담긴다.
+//
+// GET /payments/{imp_uid}
+func (s *PaymentService) Verify(impUID string, expect Expectation) (*Verdict, error) {
+	if expect.MerchantUID == "" {
+		return nil, errors.New(ErrMustExistMerchantUID)
+	}
+
+	pay, err := s.Get(impUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return VerifyPayment(pay, expect), nil
+}
+
+// VerifyPayment 이미 가져온 결제 정보를 주문 정보와 비교한다. (웹훅 등에서 결제 정보를 받은 경우)
+//
+// 결제완료(paid) 이거나, 가상계좌가 발급된 미결제(ready) 상태만 허용한다.
+// 부분취소된 결제건은 cancel_amount 불일치로 처리한다.
+func VerifyPayment(pay *TypePayment.Payment, expect Expectation) *Verdict {
+	verdict := &Verdict{Payment: pay}
+
+	currency := expect.Currency
+	if currency == "" {
+		currency = util.CurrencyKRW
+	}
+
+	actualCurrency := pay.GetCurrency()
+	if actualCurrency == "" {
+		actualCurrency = util.CurrencyKRW
+	}
+
+	verdict.compare(VerifyFieldMerchantUID, expect.MerchantUID, pay.GetMerchantUid())
+	verdict.compare(VerifyFieldAmount, strconv.Itoa(int(expect.Amount)), strconv.Itoa(int(pay.GetAmount())))
+	verdict.compare(VerifyFieldCurrency, currency, actualCurrency)
+	verdict.compare(VerifyFieldCancelAmount, "0", strconv.Itoa(int(pay.GetCancelAmount())))
+
+	status := util.PaymentStatus(pay.GetStatus())
+	awaitingDeposit := status.IsReady() && pay.GetPayMethod() == util.PayMethodVbank && pay.GetVbankNum() != ""
+	if !awaitingDeposit {
+		verdict.compare(VerifyFieldStatus, util.StatusPaid.String(), status.String())
+	}
+
+	switch {
+	case len(verdict.Mismatches) > 0:
+		verdict.Result = VerdictRejected
+	case awaitingDeposit:
+		verdict.Result = VerdictAwaitingDeposit
+	default:
+		verdict.Result = VerdictPaid
+	}
+
+	return verdict
+}
+
+func (v *Verdict) compare(field, expected, actual string) {
+	if expected != actual {
+		v.Mismatches = append(v.Mismatches, &Mismatch{Field: field, Expected: expected, Actual: actual})
+	}
+}
@@ -0,0 +1,107 @@
+package iamport
+
+import (
+	"net/http"
+	"testing"
+
+	TypePayment "github.com/iamport/interface/gen_src/go/v1/payment"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/iamport/go-iamport/contract"
+	"github.com/iamport/go-iamport/util"
+)
+
+var orderExpectation = Expectation{MerchantUID: "order_1", Amount: 1000}
+
+func paidPayment() *TypePayment.Payment {
+	return &TypePayment.Payment{
+		ImpUid:      "imp_1",
+		MerchantUid: "order_1",
+		Amount:      1000,
+		Currency:    util.CurrencyKRW,
+		Status:      string(util.StatusPaid),
+		PayMethod:   util.PayMethodCard,
+	}
+}
+
+func TestPaymentsVerify(t *testing.T) {
+	iamport, server := newContractIamport(t, func(w http.ResponseWriter, r *http.Request) {
+		contract.Respond(w, paidPayment())
+	})
+
+	verdict, err := iamport.Payments.Verify("imp_1", orderExpectation)
+	assert.NoError(t, err)
+	assert.True(t, verdict.OK())
+	assert.Empty(t, verdict.Mismatches)
+	assert.Equal(t, "imp_1", verdict.Payment.GetImpUid())
+	assert.Equal(t, "/payments/imp_1", server.LastRequest().Path)
+}
+
+func TestPaymentsVerifyRequiresMerchantUID(t *testing.T) {
+	iamport, server := newContractIamport(t, nil)
+
+	verdict, err := iamport.Payments.Verify("imp_1", Expectation{Amount: 1000})
+	assert.EqualError(t, err, ErrMustExistMerchantUID)
+	assert.Nil(t, verdict)
+	assert.Len(t, server.Requests(), 1)
+}
+
+func TestVerifyPaymentMismatches(t *testing.T) {
+	pay := paidPayment()
+	pay.MerchantUid = "order_2"
+	pay.Amount = 100
+	pay.Currency = "USD"
+
+	verdict := VerifyPayment(pay, orderExpectation)
+	assert.Equal(t, VerdictRejected, verdict.Result)
+	assert.False(t, verdict.OK())
+	assert.Len(t, verdict.Mismatches, 3)
+	assert.Equal(t, &Mismatch{Field: VerifyFieldAmount, Expected: "1000", Actual: "100"}, verdict.Mismatch(VerifyFieldAmount))
+	assert.Equal(t, "order_2", verdict.Mismatch(VerifyFieldMerchantUID).Actual)
+	assert.Equal(t, "USD", verdict.Mismatch(VerifyFieldCurrency).Actual)
+	assert.Nil(t, verdict.Mismatch(VerifyFieldStatus))
+}
+
+func TestVerifyPaymentStatus(t *testing.T) {
+	cancelled := paidPayment()
+	cancelled.Status = string(util.StatusCancelled)
+	cancelled.CancelAmount = 1000
+
+	verdict := VerifyPayment(cancelled, orderExpectation)
+	assert.Equal(t, VerdictRejected, verdict.Result)
+	assert.Equal(t, "cancelled", verdict.Mismatch(VerifyFieldStatus).Actual)
+	assert.Equal(t, "1000", verdict.Mismatch(VerifyFieldCancelAmount).Actual)
+
+	// 카드 결제의 ready 는 결제창만 열린 상태이므로 입금 대기로 보지 않는다.
+	ready := paidPayment()
+	ready.Status = string(util.StatusReady)
+	assert.Equal(t, VerdictRejected, VerifyPayment(ready, orderExpectation).Result)
+}
+
+func TestVerifyPaymentVbankReady(t *testing.T) {
+	vbank := paidPayment()
+	vbank.Status = string(util.StatusReady)
+	vbank.PayMethod = util.PayMethodVbank
+	vbank.VbankNum = "56211105948400"
+
+	verdict := VerifyPayment(vbank, orderExpectation)
+	assert.Equal(t, VerdictAwaitingDeposit, verdict.Result)
+	assert.True(t, verdict.AwaitingDeposit())
+	assert.False(t, verdict.OK())
+
+	// 입금 대기 중이어도 금액이 다르면 거절한다.
+	vbank.Amount = 999
+	assert.Equal(t, VerdictRejected, VerifyPayment(vbank, orderExpectation).Result)
+
+	// 가상계좌가 발급되지 않은 ready 는 입금 대기가 아니다.
+	vbank.Amount = 1000
+	vbank.VbankNum = ""
+	assert.Equal(t, VerdictRejected, VerifyPayment(vbank, orderExpectation).Result)
+}
+
+func TestVerifyPaymentDefaultCurrency(t *testing.T) {
+	pay := paidPayment()
+	pay.Currency = ""
+
+	assert.True(t, VerifyPayment(pay, orderExpectation).OK())
+}
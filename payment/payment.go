@@ -27,6 +27,9 @@ const (
 	URLParamFrom    = "from="
 	URLParamTo      = "to="
 	URLParamImpUids = "imp_uid[]="
+
+	// MaxImpUIDs GET /payments 한 번에 조회할 수 있는 최대 imp_uid 개수
+	MaxImpUIDs = 100
 )
 
 // GetByImpUID - GET /payments/{imp_uid}
@@ -52,6 +55,7 @@ func GetByImpUID(client *http.Client, apiDomain string, token string, params *pa
 // GetByImpUIDs - GET /payments
 // 여러 개의 아임포트 고유번호로 결제내역을 한 번에 조회합니다.(최대 100개)
 // (예시) /payments?imp_uid[]=imp_448280090638&imp_uid[]=imp_448280090639
+// MaxImpUIDs 개를 넘는 경우 나누어 요청해야 한다.
 func GetByImpUIDs(client *http.Client, apiDomain string, token string, params *payment.PaymentsRequest) (*payment.PaymentsResponse, error) {
 	urls := []string{apiDomain, URLPayments}
 
@@ -146,7 +150,7 @@ func GetByMerchantUIDs(client *http.Client, apiDomain string, token string, para
 // from, to 파라메터를 지정하여 90일 단위로 과거 데이터 조회는 가능합니다.
 func GetByStatus(client *http.Client, apiDomain string, token string, params *payment.PaymentStatusRequest) (*payment.PaymentStatusResponse, error) {
 	if params.Status == "" {
-		params.Status = util.StatusAll
+		params.Status = string(util.StatusAll)
 	}
 	urls := []string{apiDomain, URLPayments, URLStatus, "/", params.GetStatus()}
 
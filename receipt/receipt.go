@@ -0,0 +1,183 @@
+package receipt
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/iamport/go-iamport/util"
+	"github.com/iamport/go-iamport/validation"
+)
+
+const (
+	URLReceipts = "/receipts"
+)
+
+// IdentifierType 현금영수증 발급 대상 식별 수단
+type IdentifierType string
+
+const (
+	IdentifierPerson   IdentifierType = "person"   // 주민등록번호
+	IdentifierPhone    IdentifierType = "phone"    // 휴대폰 번호
+	IdentifierBusiness IdentifierType = "business" // 사업자등록번호
+	IdentifierCard     IdentifierType = "taxcard"  // 현금영수증 카드번호
+)
+
+// Purpose 현금영수증 용도
+type Purpose string
+
+const (
+	PurposePersonal Purpose = "person"  // 소득공제용
+	PurposeBusiness Purpose = "company" // 지출증빙용
+)
+
+// IsValid 아임포트에 정의된 용도인지 확인한다.
+func (p Purpose) IsValid() bool {
+	return p == PurposePersonal || p == PurposeBusiness
+}
+
+// Normalize 식별 번호에서 '-' 와 공백을 제거한다.
+func Normalize(identifier string) string {
+	return strings.NewReplacer("-", "", " ", "").Replace(identifier)
+}
+
+// IsValid identifier 가 식별 수단 형식에 맞는지 확인한다. '-' 와 공백은 무시한다.
+//
+// 주민등록번호는 13자리, 휴대폰 번호는 01 로 시작하는 10~11자리, 사업자등록번호는 검증번호가 맞는 10자리,
+// 현금영수증 카드번호는 13~19자리 숫자이다.
+// 2020년 10월 이후 발급된 주민등록번호는 검증번호 규칙이 없으므로 자릿수만 확인한다.
+func (t IdentifierType) IsValid(identifier string) bool {
+	identifier = Normalize(identifier)
+	if !validation.IsDigits(identifier) {
+		return false
+	}
+
+	switch t {
+	case IdentifierPerson:
+		return len(identifier) == 13
+	case IdentifierPhone:
+		return strings.HasPrefix(identifier, "01") && (len(identifier) == 10 || len(identifier) == 11)
+	case IdentifierBusiness:
+		return validation.IsBusinessNumber(identifier)
+	case IdentifierCard:
+		return len(identifier) >= 13 && len(identifier) <= 19
+	default:
+		return false
+	}
+}
+
+// DefaultPurpose 용도를 지정하지 않았을 때의 용도. 사업자등록번호는 지출증빙용, 나머지는 소득공제용
+func (t IdentifierType) DefaultPurpose() Purpose {
+	if t == IdentifierBusiness {
+		return PurposeBusiness
+	}
+
+	return PurposePersonal
+}
+
+// Receipt 현금영수증 발급 내역
+// interface 모듈에 receipts 메시지가 없어 아임포트 응답 필드 그대로 정의한다.
+type Receipt struct {
+	ImpUID      string  `json:"imp_uid"`
+	ReceiptTID  string  `json:"receipt_tid"` // 현금영수증 발급 거래번호
+	ApplyNum    string  `json:"apply_num"`   // 국세청 승인번호
+	Type        Purpose `json:"type"`
+	Amount      int32   `json:"amount"`
+	VAT         int32   `json:"vat"`
+	ReceiptURL  string  `json:"receipt_url"`
+	AppliedAt   int64   `json:"applied_at"`   // 발급 시각 (unix timestamp)
+	CancelledAt int64   `json:"cancelled_at"` // 발급 취소 시각 (unix timestamp), 취소되지 않았으면 0
+}
+
+// Applied 발급 시각
+func (r *Receipt) Applied() time.Time {
+	if r.AppliedAt == 0 {
+		return time.Time{}
+	}
+
+	return time.Unix(r.AppliedAt, 0)
+}
+
+// IsCancelled 발급 취소되었는지 확인한다.
+func (r *Receipt) IsCancelled() bool {
+	return r.CancelledAt != 0
+}
+
+// IssueRequest 현금영수증 발급 요청
+type IssueRequest struct {
+	ImpUID         string `json:"-"`
+	Identifier     string `json:"identifier"`
+	IdentifierType string `json:"identifier_type"`
+	Type           string `json:"type"`
+	BuyerName      string `json:"buyer_name,omitempty"`
+	BuyerEmail     string `json:"buyer_email,omitempty"`
+	BuyerTel       string `json:"buyer_tel,omitempty"`
+	TaxFree        int32  `json:"tax_free,omitempty"` // 면세공급가액
+}
+
+// ReceiptRequest 현금영수증 조회, 발급 취소 요청
+type ReceiptRequest struct {
+	ImpUID string
+}
+
+// ReceiptResponse 현금영수증 응답
+type ReceiptResponse struct {
+	Code     int32    `json:"code"`
+	Message  string   `json:"message"`
+	Response *Receipt `json:"response"`
+}
+
+// Issue - POST /receipts/{imp_uid}
+// 계좌이체, 가상계좌 결제건에 대해 현금영수증을 발급합니다.
+func Issue(client *http.Client, apiDomain string, token string, params *IssueRequest) (*ReceiptResponse, error) {
+	urlReceipt := strings.Join([]string{apiDomain, URLReceipts, "/", params.ImpUID}, "")
+
+	jsonBytes, err := json.Marshal(params)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := util.CallWithJson(client, token, urlReceipt, util.POST, jsonBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return unmarshalReceipt(res)
+}
+
+// GetByImpUID - GET /receipts/{imp_uid}
+// 결제건에 발급된 현금영수증을 조회합니다.
+func GetByImpUID(client *http.Client, apiDomain string, token string, params *ReceiptRequest) (*ReceiptResponse, error) {
+	urlReceipt := strings.Join([]string{apiDomain, URLReceipts, "/", params.ImpUID}, "")
+
+	res, err := util.Call(client, token, urlReceipt, util.GET)
+	if err != nil {
+		return nil, err
+	}
+
+	return unmarshalReceipt(res)
+}
+
+// Cancel - DELETE /receipts/{imp_uid}
+// 결제건에 발급된 현금영수증을 취소합니다.
+func Cancel(client *http.Client, apiDomain string, token string, params *ReceiptRequest) (*ReceiptResponse, error) {
+	urlReceipt := strings.Join([]string{apiDomain, URLReceipts, "/", params.ImpUID}, "")
+
+	res, err := util.Call(client, token, urlReceipt, util.DELETE)
+	if err != nil {
+		return nil, err
+	}
+
+	return unmarshalReceipt(res)
+}
+
+func unmarshalReceipt(res []byte) (*ReceiptResponse, error) {
+	receiptRes := ReceiptResponse{}
+	err := json.Unmarshal(res, &receiptRes)
+	if err != nil {
+		return nil, err
+	}
+
+	return &receiptRes, nil
+}
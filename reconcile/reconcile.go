@@ -0,0 +1,255 @@
+package reconcile
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"sort"
+	"strconv"
+	"time"
+
+	TypePayment "github.com/iamport/interface/gen_src/go/v1/payment"
+
+	"github.com/iamport/go-iamport/iamport"
+	"github.com/iamport/go-iamport/util"
+)
+
+// Record 가맹점 주문 정보
+type Record struct {
+	MerchantUID string             `json:"merchant_uid"`
+	Amount      int32              `json:"amount"`           // 기대하는 결제금액
+	Status      util.PaymentStatus `json:"status,omitempty"` // 기대하는 결제상태, 비어있으면 비교하지 않는다.
+}
+
+// Records 가맹점 주문 정보를 하나씩 넘겨준다.
+type Records interface {
+	// Next 다음 주문 정보가 있으면 true
+	Next() bool
+	Record() *Record
+	Err() error
+}
+
+// SliceRecords 슬라이스를 Records 로 감싼다.
+func SliceRecords(records []*Record) Records {
+	return &sliceRecords{records: records, index: -1}
+}
+
+type sliceRecords struct {
+	records []*Record
+	index   int
+}
+
+func (s *sliceRecords) Next() bool {
+	s.index++
+	return s.index < len(s.records)
+}
+
+func (s *sliceRecords) Record() *Record {
+	return s.records[s.index]
+}
+
+func (s *sliceRecords) Err() error {
+	return nil
+}
+
+// Kind 불일치 종류
+type Kind string
+
+const (
+	MissingInIamport Kind = "missing_in_iamport" // 가맹점에만 있는 주문
+	MissingLocally   Kind = "missing_locally"    // 아임포트에만 있는 결제건
+	AmountMismatch   Kind = "amount_mismatch"    // 결제금액 불일치
+	StatusMismatch   Kind = "status_mismatch"    // 결제상태 불일치
+)
+
+// Discrepancy 불일치 항목
+type Discrepancy struct {
+	Kind        Kind   `json:"kind"`
+	MerchantUID string `json:"merchant_uid"`
+	ImpUID      string `json:"imp_uid,omitempty"`
+	Expected    string `json:"expected,omitempty"` // 가맹점 값
+	Actual      string `json:"actual,omitempty"`   // 아임포트 값
+}
+
+// Report 대사 결과
+type Report struct {
+	From          time.Time      `json:"from"`
+	To            time.Time      `json:"to"`
+	Records       int            `json:"records"`  // 비교한 가맹점 주문 수
+	Payments      int            `json:"payments"` // 기간 내 아임포트 주문(merchant_uid) 수
+	Matched       int            `json:"matched"`  // 일치한 주문 수
+	Discrepancies []*Discrepancy `json:"discrepancies"`
+}
+
+// OK 불일치 항목이 없으면 true
+func (r *Report) OK() bool {
+	return len(r.Discrepancies) == 0
+}
+
+// Filter kind 에 해당하는 불일치 항목
+func (r *Report) Filter(kind Kind) []*Discrepancy {
+	var filtered []*Discrepancy
+	for _, d := range r.Discrepancies {
+		if d.Kind == kind {
+			filtered = append(filtered, d)
+		}
+	}
+
+	return filtered
+}
+
+// WriteJSON 대사 결과를 JSON 으로 기록한다.
+func (r *Report) WriteJSON(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+
+	return encoder.Encode(r)
+}
+
+// Options 대사 조건
+type Options struct {
+	// RangeOptions 아임포트 결제건을 조회할 기간. 3개월을 넘으면 나누어 조회한다.
+	// Limiter 는 기간 밖 주문을 merchant_uid 로 조회할 때에도 사용한다.
+	iamport.RangeOptions
+}
+
+// Reconcile 가맹점 주문 정보와 아임포트 결제건을 비교한다.
+//
+// 기간 내 결제건을 모두 가져온 뒤 merchant_uid 별로 주문 처리 기준이 되는 결제건(iamport.AuthoritativeAttempt)과 비교한다.
+// 기간 내에 없는 주문은 merchant_uid 로 다시 조회하므로, 기간 경계에 걸친 주문은 누락으로 잡히지 않는다.
+// 기간 내 결제건 중 records 에 없는 주문은 MissingLocally 로 기록한다.
+func Reconcile(ctx context.Context, iam *iamport.Iamport, records Records, opts *Options) (*Report, error) {
+	if opts == nil {
+		opts = &Options{}
+	}
+
+	rangeOpts := opts.RangeOptions
+	if rangeOpts.To.IsZero() {
+		rangeOpts.To = time.Now()
+	}
+	if rangeOpts.From.IsZero() {
+		rangeOpts.From = iamport.ListPeriodStart(rangeOpts.To)
+	}
+
+	payments, err := iam.Payments.ListRange(ctx, &rangeOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	attempts := map[string][]*TypePayment.Payment{}
+	for _, pay := range payments {
+		attempts[pay.GetMerchantUid()] = append(attempts[pay.GetMerchantUid()], pay)
+	}
+
+	report := &Report{
+		From:          rangeOpts.From,
+		To:            rangeOpts.To,
+		Payments:      len(attempts),
+		Discrepancies: []*Discrepancy{},
+	}
+
+	compared := map[string]bool{}
+	for records.Next() {
+		record := records.Record()
+		report.Records++
+		compared[record.MerchantUID] = true
+
+		pay := iamport.AuthoritativeAttempt(attempts[record.MerchantUID])
+		if pay == nil {
+			if pay, err = findAuthoritative(ctx, iam, record.MerchantUID, rangeOpts.Limiter); err != nil {
+				return nil, err
+			}
+		}
+
+		if pay == nil {
+			report.add(&Discrepancy{
+				Kind:        MissingInIamport,
+				MerchantUID: record.MerchantUID,
+				Expected:    strconv.Itoa(int(record.Amount)),
+			})
+			continue
+		}
+
+		if discrepancies := compare(record, pay); len(discrepancies) > 0 {
+			report.add(discrepancies...)
+			continue
+		}
+
+		report.Matched++
+	}
+
+	if err := records.Err(); err != nil {
+		return nil, err
+	}
+
+	var missing []string
+	for muid := range attempts {
+		if !compared[muid] {
+			missing = append(missing, muid)
+		}
+	}
+	sort.Strings(missing)
+
+	for _, muid := range missing {
+		pay := iamport.AuthoritativeAttempt(attempts[muid])
+		report.add(&Discrepancy{
+			Kind:        MissingLocally,
+			MerchantUID: muid,
+			ImpUID:      pay.GetImpUid(),
+			Actual:      strconv.Itoa(int(pay.GetAmount())),
+		})
+	}
+
+	return report, nil
+}
+
+func (r *Report) add(discrepancies ...*Discrepancy) {
+	r.Discrepancies = append(r.Discrepancies, discrepancies...)
+}
+
+// compare 주문 정보와 결제건의 결제금액, 결제상태를 비교한다.
+func compare(record *Record, pay *TypePayment.Payment) []*Discrepancy {
+	var discrepancies []*Discrepancy
+
+	if pay.GetAmount() != record.Amount {
+		discrepancies = append(discrepancies, &Discrepancy{
+			Kind:        AmountMismatch,
+			MerchantUID: record.MerchantUID,
+			ImpUID:      pay.GetImpUid(),
+			Expected:    strconv.Itoa(int(record.Amount)),
+			Actual:      strconv.Itoa(int(pay.GetAmount())),
+		})
+	}
+
+	if record.Status != "" && util.PaymentStatus(pay.GetStatus()) != record.Status {
+		discrepancies = append(discrepancies, &Discrepancy{
+			Kind:        StatusMismatch,
+			MerchantUID: record.MerchantUID,
+			ImpUID:      pay.GetImpUid(),
+			Expected:    string(record.Status),
+			Actual:      pay.GetStatus(),
+		})
+	}
+
+	return discrepancies
+}
+
+// findAuthoritative merchant_uid 로 주문 처리 기준 결제건을 조회한다. 결제건이 없으면 nil, nil
+func findAuthoritative(ctx context.Context, iam *iamport.Iamport, muid string, limiter iamport.Limiter) (*TypePayment.Payment, error) {
+	if limiter != nil {
+		if err := limiter.Wait(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	pay, err := iam.Payments.FindAuthoritative(ctx, muid)
+	if err != nil {
+		// 결제 시도가 없는 merchant_uid 는 아임포트가 404 로 응답한다.
+		if err.Error() == iamport.ErrNotFoundPayment {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return pay, nil
+}
@@ -0,0 +1,154 @@
+package reconcile
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	TypePayment "github.com/iamport/interface/gen_src/go/v1/payment"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/iamport/go-iamport/contract"
+	"github.com/iamport/go-iamport/iamport"
+	"github.com/iamport/go-iamport/util"
+)
+
+var base = time.Date(2021, 3, 1, 0, 0, 0, 0, time.UTC)
+
+var rangePayments = []*TypePayment.Payment{
+	{ImpUid: "imp_1", MerchantUid: "order_1", Status: string(util.StatusPaid), Amount: 1000},
+	{ImpUid: "imp_2", MerchantUid: "order_2", Status: string(util.StatusPaid), Amount: 1500},
+	{ImpUid: "imp_3a", MerchantUid: "order_3", Status: string(util.StatusFailed), Amount: 3000, StartedAt: 10},
+	{ImpUid: "imp_3b", MerchantUid: "order_3", Status: string(util.StatusPaid), Amount: 3000, StartedAt: 20, PaidAt: 21},
+	{ImpUid: "imp_4", MerchantUid: "order_4", Status: string(util.StatusReady), Amount: 4000},
+	{ImpUid: "imp_9", MerchantUid: "order_9", Status: string(util.StatusPaid), Amount: 9000},
+}
+
+// 기간 밖에서 결제된 주문
+var outsidePayment = &TypePayment.Payment{ImpUid: "imp_5", MerchantUid: "order_5", Status: string(util.StatusPaid), Amount: 5000}
+
+func newReconcileIamport(t *testing.T) (*iamport.Iamport, *contract.Server) {
+	server := contract.NewServer(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/payments/status/all":
+			contract.Respond(w, &TypePayment.PaymentPage{List: rangePayments})
+		case r.URL.Path == "/payments/findAll/order_5/":
+			contract.Respond(w, &TypePayment.PaymentPage{List: []*TypePayment.Payment{outsidePayment}})
+		case strings.HasPrefix(r.URL.Path, "/payments/findAll/"):
+			w.WriteHeader(http.StatusNotFound)
+			contract.RespondError(w, "결제건이 존재하지 않습니다.")
+		default:
+			t.Errorf("unexpected request %s", r.URL.Path)
+		}
+	})
+	t.Cleanup(server.Close)
+
+	auth, err := server.Authenticate()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return iamport.NewIamportWithAuthenticate(auth), server
+}
+
+func TestReconcile(t *testing.T) {
+	iam, server := newReconcileIamport(t)
+
+	records := SliceRecords([]*Record{
+		{MerchantUID: "order_1", Amount: 1000, Status: util.StatusPaid},
+		{MerchantUID: "order_2", Amount: 2000},
+		{MerchantUID: "order_3", Amount: 3000, Status: util.StatusPaid},
+		{MerchantUID: "order_4", Amount: 4000, Status: util.StatusPaid},
+		{MerchantUID: "order_5", Amount: 5000, Status: util.StatusPaid},
+		{MerchantUID: "order_6", Amount: 6000},
+	})
+
+	report, err := Reconcile(context.Background(), iam, records, &Options{
+		RangeOptions: iamport.RangeOptions{ListOptions: iamport.ListOptions{From: base, To: base.AddDate(0, 1, 0)}},
+	})
+	assert.NoError(t, err)
+
+	assert.False(t, report.OK())
+	assert.Equal(t, 6, report.Records)
+	assert.Equal(t, 5, report.Payments)
+	assert.Equal(t, 3, report.Matched)
+	assert.Equal(t, []*Discrepancy{
+		{Kind: AmountMismatch, MerchantUID: "order_2", ImpUID: "imp_2", Expected: "2000", Actual: "1500"},
+		{Kind: StatusMismatch, MerchantUID: "order_4", ImpUID: "imp_4", Expected: "paid", Actual: "ready"},
+		{Kind: MissingInIamport, MerchantUID: "order_6", Expected: "6000"},
+		{Kind: MissingLocally, MerchantUID: "order_9", ImpUID: "imp_9", Actual: "9000"},
+	}, report.Discrepancies)
+	assert.Len(t, report.Filter(MissingLocally), 1)
+
+	// 목록 조회 1번과 기간 밖 주문 조회 2번 (order_4 는 기간 내에 있으므로 조회하지 않는다)
+	paths := []string{}
+	for _, r := range server.Requests()[1:] {
+		paths = append(paths, r.Path)
+	}
+	assert.Equal(t, []string{"/payments/status/all", "/payments/findAll/order_5/", "/payments/findAll/order_6/"}, paths)
+}
+
+func TestReportWriteJSON(t *testing.T) {
+	report := &Report{
+		From:          base,
+		To:            base,
+		Records:       1,
+		Discrepancies: []*Discrepancy{{Kind: MissingInIamport, MerchantUID: "order_1", Expected: "1000"}},
+	}
+
+	var buf bytes.Buffer
+	assert.NoError(t, report.WriteJSON(&buf))
+
+	var decoded map[string]interface{}
+	assert.NoError(t, json.Unmarshal(buf.Bytes(), &decoded))
+	assert.Equal(t, "2021-03-01T00:00:00Z", decoded["from"])
+	assert.Equal(t, []interface{}{map[string]interface{}{
+		"kind": "missing_in_iamport", "merchant_uid": "order_1", "expected": "1000",
+	}}, decoded["discrepancies"])
+}
+
+func TestReconcileEmptyReport(t *testing.T) {
+	iam, _ := newReconcileIamport(t)
+	saved := rangePayments
+	rangePayments = nil
+	defer func() { rangePayments = saved }()
+
+	report, err := Reconcile(context.Background(), iam, SliceRecords(nil), nil)
+	assert.NoError(t, err)
+	assert.True(t, report.OK())
+	assert.Equal(t, []*Discrepancy{}, report.Discrepancies)
+}
+
+func TestReconcileFromFebruary(t *testing.T) {
+	iam, server := newReconcileIamport(t)
+	saved := rangePayments
+	rangePayments = nil
+	defer func() { rangePayments = saved }()
+
+	// 2월 1일 ~ 5월 1일은 89일이다. 90일 단위로 나누면 첫 구간이 조회 기간 제한에 걸린다.
+	from := time.Date(2023, 2, 1, 0, 0, 0, 0, time.UTC)
+	report, err := Reconcile(context.Background(), iam, SliceRecords([]*Record{
+		{MerchantUID: "order_5", Amount: 5000, Status: util.StatusPaid},
+	}), &Options{
+		RangeOptions: iamport.RangeOptions{ListOptions: iamport.ListOptions{From: from, To: from.AddDate(1, 0, 0)}},
+	})
+	assert.NoError(t, err)
+	assert.True(t, report.OK())
+	assert.Equal(t, 1, report.Matched)
+	// 토큰 발급 + 3개월 구간 4개 + 기간 밖 주문 조회
+	assert.Len(t, server.Requests(), 6)
+
+	// From 을 지정하지 않으면 To 기준 3개월 전부터 조회한다.
+	server.Reset()
+	to := time.Date(2023, 5, 2, 0, 0, 0, 0, time.UTC)
+	_, err = Reconcile(context.Background(), iam, SliceRecords(nil), &Options{
+		RangeOptions: iamport.RangeOptions{ListOptions: iamport.ListOptions{To: to}},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, fmt.Sprintf("page=1&from=%d&to=%d", to.AddDate(0, -3, 0).Unix(), to.Unix()), server.LastRequest().RawQuery)
+}
@@ -0,0 +1,169 @@
+package refund
+
+import (
+	"sort"
+	"time"
+
+	TypePayment "github.com/iamport/interface/gen_src/go/v1/payment"
+
+	"github.com/iamport/go-iamport/util"
+)
+
+// State 결제건의 취소 상태
+type State string
+
+const (
+	StateNone    State = "none"    // 취소 내역 없음
+	StatePartial State = "partial" // 부분 취소
+	StateFull    State = "full"    // 전액 취소
+)
+
+// Entry 취소 내역 한 건
+type Entry struct {
+	CancelledAt time.Time
+	Amount      int32
+	Reason      string
+	PgTid       string
+	ReceiptURL  string // 취소 매출전표
+
+	Cumulative int32 // 이 취소까지의 누적 취소 금액
+	Remaining  int32 // 이 취소 이후 취소 가능 잔액
+}
+
+// Summary 결제건의 환불 현황
+type Summary struct {
+	ImpUID     string
+	Amount     int32 // 결제금액
+	Cancelled  int32 // 취소된 금액
+	Refundable int32 // 취소 가능 잔액
+	State      State
+	Ledger     []*Entry // 취소 내역 (오래된 순)
+}
+
+// Summarize 결제 정보로 환불 현황을 계산한다.
+//
+// 취소된 금액은 cancel_amount 와 cancel_history 합계 중 큰 값으로 본다.
+// (응답 시점에 따라 둘 중 하나만 갱신되어 있을 수 있다)
+func Summarize(pay *TypePayment.Payment) *Summary {
+	summary := &Summary{
+		ImpUID: pay.GetImpUid(),
+		Amount: pay.GetAmount(),
+	}
+
+	history := append([]*TypePayment.CancelHistory{}, pay.GetCancelHistory()...)
+	sort.SliceStable(history, func(i, j int) bool {
+		return history[i].GetCancelledAt() < history[j].GetCancelledAt()
+	})
+
+	var cumulative int32
+	for _, h := range history {
+		cumulative += h.GetAmount()
+		summary.Ledger = append(summary.Ledger, &Entry{
+			CancelledAt: time.Unix(int64(h.GetCancelledAt()), 0),
+			Amount:      h.GetAmount(),
+			Reason:      h.GetReason(),
+			PgTid:       h.GetPgTid(),
+			ReceiptURL:  h.GetReceiptUrl(),
+			Cumulative:  cumulative,
+			Remaining:   nonNegative(summary.Amount - cumulative),
+		})
+	}
+
+	summary.Cancelled = pay.GetCancelAmount()
+	if cumulative > summary.Cancelled {
+		summary.Cancelled = cumulative
+	}
+	summary.Refundable = nonNegative(summary.Amount - summary.Cancelled)
+
+	switch {
+	case summary.Cancelled == 0 && !util.PaymentStatus(pay.GetStatus()).IsCancelled():
+		summary.State = StateNone
+	case summary.Refundable == 0 || util.PaymentStatus(pay.GetStatus()).IsCancelled():
+		summary.State = StateFull
+	default:
+		summary.State = StatePartial
+	}
+
+	return summary
+}
+
+// IsFullyCancelled 전액 취소되었는지 확인한다.
+func (s *Summary) IsFullyCancelled() bool {
+	return s.State == StateFull
+}
+
+// IsPartiallyCancelled 일부만 취소되었는지 확인한다.
+func (s *Summary) IsPartiallyCancelled() bool {
+	return s.State == StatePartial
+}
+
+// Receipts 취소 매출전표 URL 목록 (오래된 순, 전표가 없는 취소는 제외)
+func (s *Summary) Receipts() []string {
+	var receipts []string
+	for _, entry := range s.Ledger {
+		if entry.ReceiptURL != "" {
+			receipts = append(receipts, entry.ReceiptURL)
+		}
+	}
+
+	return receipts
+}
+
+// Breakdown 금액의 면세공급가액 / 공급가액 / 부가세 구성
+type Breakdown struct {
+	Amount  int32
+	TaxFree int32 // 면세공급가액
+	Supply  int32 // 과세 공급가액
+	VAT     int32 // 부가세
+}
+
+// Split 금액을 면세공급가액, 공급가액, 부가세로 나눈다.
+// 부가세는 과세금액(amount - taxFree)의 1/11 을 반올림한 금액이다. (아임포트 vat_amount 자동 계산과 같다)
+func Split(amount, taxFree int32) Breakdown {
+	if taxFree > amount {
+		taxFree = amount
+	}
+
+	if taxFree < 0 {
+		taxFree = 0
+	}
+
+	taxable := amount - taxFree
+	vat := (taxable + 5) / 11
+
+	return Breakdown{
+		Amount:  amount,
+		TaxFree: taxFree,
+		Supply:  taxable - vat,
+		VAT:     vat,
+	}
+}
+
+// ProratedTaxFree 원 결제의 면세 비율로 취소 금액 중 면세공급가액을 계산한다. (원 단위 미만 버림)
+// 아임포트 결제 정보에는 면세공급가액이 없으므로 totalTaxFree 는 가맹점이 알고 있는 값을 사용한다.
+func ProratedTaxFree(totalAmount, totalTaxFree, cancelAmount int32) int32 {
+	if totalAmount <= 0 || totalTaxFree <= 0 || cancelAmount <= 0 {
+		return 0
+	}
+
+	taxFree := int32(int64(cancelAmount) * int64(totalTaxFree) / int64(totalAmount))
+	if taxFree > cancelAmount {
+		return cancelAmount
+	}
+
+	return taxFree
+}
+
+// RefundableBreakdown 취소 가능 잔액의 면세/과세 구성
+// taxFreeRemaining 은 아직 취소되지 않은 면세공급가액 (가맹점이 관리하는 값)
+func (s *Summary) RefundableBreakdown(taxFreeRemaining int32) Breakdown {
+	return Split(s.Refundable, taxFreeRemaining)
+}
+
+func nonNegative(v int32) int32 {
+	if v < 0 {
+		return 0
+	}
+
+	return v
+}
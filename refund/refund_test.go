@@ -0,0 +1,100 @@
+package refund
+
+import (
+	"testing"
+	"time"
+
+	TypePayment "github.com/iamport/interface/gen_src/go/v1/payment"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/iamport/go-iamport/util"
+)
+
+func cancelledPayment() *TypePayment.Payment {
+	return &TypePayment.Payment{
+		ImpUid:       "imp_1",
+		Amount:       10000,
+		CancelAmount: 4000,
+		Status:       string(util.StatusPaid),
+		CancelHistory: []*TypePayment.CancelHistory{
+			{Amount: 3000, CancelledAt: 1600000200, Reason: "두번째", ReceiptUrl: "https://receipt/2"},
+			{Amount: 1000, CancelledAt: 1600000100, Reason: "첫번째", PgTid: "tid_1"},
+		},
+	}
+}
+
+func TestSummarizeNone(t *testing.T) {
+	summary := Summarize(&TypePayment.Payment{ImpUid: "imp_1", Amount: 10000, Status: string(util.StatusPaid)})
+
+	assert.Equal(t, StateNone, summary.State)
+	assert.Equal(t, int32(10000), summary.Refundable)
+	assert.Empty(t, summary.Ledger)
+}
+
+func TestSummarizePartial(t *testing.T) {
+	summary := Summarize(cancelledPayment())
+
+	assert.Equal(t, StatePartial, summary.State)
+	assert.True(t, summary.IsPartiallyCancelled())
+	assert.Equal(t, int32(4000), summary.Cancelled)
+	assert.Equal(t, int32(6000), summary.Refundable)
+
+	// 취소 시각 순으로 정렬된다.
+	assert.Len(t, summary.Ledger, 2)
+	assert.Equal(t, &Entry{
+		CancelledAt: time.Unix(1600000100, 0),
+		Amount:      1000,
+		Reason:      "첫번째",
+		PgTid:       "tid_1",
+		Cumulative:  1000,
+		Remaining:   9000,
+	}, summary.Ledger[0])
+	assert.Equal(t, int32(4000), summary.Ledger[1].Cumulative)
+	assert.Equal(t, int32(6000), summary.Ledger[1].Remaining)
+	assert.Equal(t, []string{"https://receipt/2"}, summary.Receipts())
+}
+
+func TestSummarizeStaleCancelAmount(t *testing.T) {
+	pay := cancelledPayment()
+	pay.CancelAmount = 1000
+
+	assert.Equal(t, int32(6000), Summarize(pay).Refundable)
+}
+
+func TestSummarizeFull(t *testing.T) {
+	pay := cancelledPayment()
+	pay.CancelAmount = 10000
+	pay.Status = string(util.StatusCancelled)
+	pay.CancelHistory = append(pay.CancelHistory, &TypePayment.CancelHistory{Amount: 6000, CancelledAt: 1600000300})
+
+	summary := Summarize(pay)
+	assert.Equal(t, StateFull, summary.State)
+	assert.True(t, summary.IsFullyCancelled())
+	assert.Equal(t, int32(0), summary.Refundable)
+
+	// 취소 내역 없이 cancelled 로 내려오는 결제건 (결제 전 취소 등)
+	assert.Equal(t, StateFull, Summarize(&TypePayment.Payment{Status: string(util.StatusCancelled)}).State)
+}
+
+func TestSplit(t *testing.T) {
+	assert.Equal(t, Breakdown{Amount: 11000, Supply: 10000, VAT: 1000}, Split(11000, 0))
+	assert.Equal(t, Breakdown{Amount: 12000, TaxFree: 1000, Supply: 10000, VAT: 1000}, Split(12000, 1000))
+	assert.Equal(t, Breakdown{Amount: 1000, TaxFree: 1000}, Split(1000, 2000))
+
+	// 1/11 반올림: 105/11 = 9.54 → 10, 104/11 = 9.45 → 9
+	assert.Equal(t, int32(10), Split(105, 0).VAT)
+	assert.Equal(t, int32(9), Split(104, 0).VAT)
+}
+
+func TestProratedTaxFree(t *testing.T) {
+	assert.Equal(t, int32(300), ProratedTaxFree(10000, 1000, 3000))
+	assert.Equal(t, int32(333), ProratedTaxFree(3000, 1000, 1000))
+	assert.Equal(t, int32(0), ProratedTaxFree(10000, 0, 3000))
+	assert.Equal(t, int32(0), ProratedTaxFree(0, 1000, 3000))
+}
+
+func TestRefundableBreakdown(t *testing.T) {
+	summary := Summarize(cancelledPayment())
+
+	assert.Equal(t, Breakdown{Amount: 6000, TaxFree: 500, Supply: 5000, VAT: 500}, summary.RefundableBreakdown(500))
+}
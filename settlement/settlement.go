@@ -0,0 +1,382 @@
+package settlement
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"time"
+
+	TypePayment "github.com/iamport/interface/gen_src/go/v1/payment"
+
+	"github.com/iamport/go-iamport/export"
+	"github.com/iamport/go-iamport/iamport"
+	"github.com/iamport/go-iamport/refund"
+	"github.com/iamport/go-iamport/util"
+)
+
+const (
+	ErrUnknownDimension = "iamport: unknown settlement dimension"
+
+	// DateLayout 집계 일자 형식
+	DateLayout = "2006-01-02"
+)
+
+// Dimension 집계 기준
+type Dimension string
+
+const (
+	DimensionPayMethod  Dimension = "pay_method"  // 결제수단
+	DimensionPGProvider Dimension = "pg_provider" // PG사
+	DimensionCardName   Dimension = "card_name"   // 카드사
+	DimensionChannel    Dimension = "channel"     // 결제환경 (pc, mobile, api)
+)
+
+// AllDimensions 지원하는 모든 집계 기준
+var AllDimensions = []Dimension{DimensionPayMethod, DimensionPGProvider, DimensionCardName, DimensionChannel}
+
+// IsValid 지원하는 집계 기준인지 확인한다.
+func (d Dimension) IsValid() bool {
+	for _, dimension := range AllDimensions {
+		if d == dimension {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (d Dimension) value(pay *TypePayment.Payment) string {
+	switch d {
+	case DimensionPayMethod:
+		return pay.GetPayMethod()
+	case DimensionPGProvider:
+		return pay.GetPgProvider()
+	case DimensionCardName:
+		return pay.GetCardName()
+	case DimensionChannel:
+		return pay.GetChannel()
+	default:
+		return ""
+	}
+}
+
+// Row 일자, 집계 기준별 합계
+// 집계 기준으로 지정하지 않은 필드는 비어있다.
+type Row struct {
+	Date       string `json:"date"` // 일자 (YYYY-MM-DD)
+	PayMethod  string `json:"pay_method,omitempty"`
+	PGProvider string `json:"pg_provider,omitempty"`
+	CardName   string `json:"card_name,omitempty"`
+	Channel    string `json:"channel,omitempty"`
+	Currency   string `json:"currency"`
+
+	PaidCount   int   `json:"paid_count"`   // 결제완료 건수
+	Gross       int64 `json:"gross"`        // 결제금액 합계
+	CancelCount int   `json:"cancel_count"` // 취소 건수 (부분 취소는 각각 한 건)
+	Cancelled   int64 `json:"cancelled"`    // 취소금액 합계
+	Net         int64 `json:"net"`          // 결제금액 - 취소금액
+}
+
+// Dimension 집계 기준 값
+func (r *Row) Dimension(d Dimension) string {
+	switch d {
+	case DimensionPayMethod:
+		return r.PayMethod
+	case DimensionPGProvider:
+		return r.PGProvider
+	case DimensionCardName:
+		return r.CardName
+	case DimensionChannel:
+		return r.Channel
+	default:
+		return ""
+	}
+}
+
+func (r *Row) setDimension(d Dimension, value string) {
+	switch d {
+	case DimensionPayMethod:
+		r.PayMethod = value
+	case DimensionPGProvider:
+		r.PGProvider = value
+	case DimensionCardName:
+		r.CardName = value
+	case DimensionChannel:
+		r.Channel = value
+	}
+}
+
+// Summary 정산 집계 결과
+type Summary struct {
+	GroupBy []Dimension `json:"group_by"`
+	Rows    []*Row      `json:"rows"` // 일자, 집계 기준, 통화 순
+}
+
+// Totals 통화별 전체 합계. Date 와 집계 기준 필드는 비어있다.
+func (s *Summary) Totals() []*Row {
+	totals := map[string]*Row{}
+	var currencies []string
+	for _, row := range s.Rows {
+		total, ok := totals[row.Currency]
+		if !ok {
+			total = &Row{Currency: row.Currency}
+			totals[row.Currency] = total
+			currencies = append(currencies, row.Currency)
+		}
+
+		total.PaidCount += row.PaidCount
+		total.Gross += row.Gross
+		total.CancelCount += row.CancelCount
+		total.Cancelled += row.Cancelled
+		total.Net += row.Net
+	}
+
+	sort.Strings(currencies)
+
+	rows := make([]*Row, len(currencies))
+	for i, currency := range currencies {
+		rows[i] = totals[currency]
+	}
+
+	return rows
+}
+
+// Options 정산 집계 조건
+type Options struct {
+	// RangeOptions 집계할 결제건의 조회 조건. 3개월을 넘으면 나누어 조회하며 Sorting 은 최종수정시각(updated)으로 고정된다.
+	iamport.RangeOptions
+
+	GroupBy  []Dimension    // 집계 기준, 비어있으면 AllDimensions
+	Location *time.Location // 일자 기준, nil 이면 KST
+}
+
+// Summarize 조건에 맞는 결제건을 모두 조회하여 일자, 집계 기준별로 합계를 낸다.
+//
+// 결제건은 최종수정시각(updated) 기준으로 조회하므로 기간 밖에 시작, 결제되었다가 기간 안에 결제완료, 취소된 결제건도 포함된다.
+// RangeOptions.Sorting 은 무시한다.
+// 결제금액과 취소금액은 결제완료, 취소 시각이 [From, To) 안에 있는 것만 집계한다.
+func Summarize(ctx context.Context, iam *iamport.Iamport, opts *Options) (*Summary, error) {
+	if opts == nil {
+		opts = &Options{}
+	}
+
+	if err := validateDimensions(opts.GroupBy); err != nil {
+		return nil, err
+	}
+
+	// 아임포트는 정렬 기준 시각으로 기간을 적용하므로 시작 시각(-started)이 아니라 최종수정시각으로 조회한다.
+	rangeOpts := opts.RangeOptions
+	rangeOpts.Sorting = util.SortASCUpdated
+	if rangeOpts.To.IsZero() {
+		rangeOpts.To = time.Now()
+	}
+	if rangeOpts.From.IsZero() {
+		rangeOpts.From = iamport.ListPeriodStart(rangeOpts.To)
+	}
+
+	payments, err := iam.Payments.ListRange(ctx, &rangeOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	return aggregate(payments, opts.GroupBy, opts.Location, &iamport.Period{From: rangeOpts.From, To: rangeOpts.To})
+}
+
+// Aggregate 결제건을 일자, 집계 기준별로 합계를 낸다.
+//
+// 결제금액은 결제완료 일자에, 취소금액은 각 취소 일자에 집계하므로
+// 지난 결제건의 취소는 취소한 날의 순매출을 줄인다.
+// 결제완료된 적 없는 결제건(미결제, 결제실패, 가상계좌 입금 전 취소)은 집계하지 않는다.
+func Aggregate(payments []*TypePayment.Payment, groupBy []Dimension, loc *time.Location) (*Summary, error) {
+	return aggregate(payments, groupBy, loc, nil)
+}
+
+// aggregate period 가 있으면 결제완료, 취소 시각이 [period.From, period.To) 안에 있는 금액만 집계한다.
+func aggregate(payments []*TypePayment.Payment, groupBy []Dimension, loc *time.Location, period *iamport.Period) (*Summary, error) {
+	if err := validateDimensions(groupBy); err != nil {
+		return nil, err
+	}
+
+	if len(groupBy) == 0 {
+		groupBy = AllDimensions
+	}
+
+	if loc == nil {
+		loc = util.KST
+	}
+
+	inPeriod := func(at int32) bool {
+		if period == nil {
+			return true
+		}
+
+		t := time.Unix(int64(at), 0)
+		return !t.Before(period.From) && t.Before(period.To)
+	}
+
+	rows := map[string]*Row{}
+	row := func(pay *TypePayment.Payment, at int32) *Row {
+		key := &Row{
+			Date:     time.Unix(int64(at), 0).In(loc).Format(DateLayout),
+			Currency: pay.GetCurrency(),
+		}
+		for _, d := range groupBy {
+			key.setDimension(d, d.value(pay))
+		}
+
+		id := fmt.Sprintf("%s\x00%s\x00%s\x00%s\x00%s\x00%s", key.Date, key.PayMethod, key.PGProvider, key.CardName, key.Channel, key.Currency)
+		if existing, ok := rows[id]; ok {
+			return existing
+		}
+
+		rows[id] = key
+		return key
+	}
+
+	for _, pay := range payments {
+		if pay.GetPaidAt() == 0 {
+			continue
+		}
+
+		if inPeriod(pay.GetPaidAt()) {
+			paid := row(pay, pay.GetPaidAt())
+			paid.PaidCount++
+			paid.Gross += int64(pay.GetAmount())
+			paid.Net += int64(pay.GetAmount())
+		}
+
+		summary := refund.Summarize(pay)
+
+		var recorded int32
+		for _, entry := range summary.Ledger {
+			recorded += entry.Amount
+			if !inPeriod(int32(entry.CancelledAt.Unix())) {
+				continue
+			}
+
+			cancelled := row(pay, int32(entry.CancelledAt.Unix()))
+			cancelled.CancelCount++
+			cancelled.Cancelled += int64(entry.Amount)
+			cancelled.Net -= int64(entry.Amount)
+		}
+
+		// 취소 내역에 없는 취소 금액은 결제취소 시각에 집계한다.
+		total := summary.Cancelled
+		if summary.IsFullyCancelled() {
+			total = summary.Amount
+		}
+
+		if rest := total - recorded; rest > 0 {
+			at := pay.GetCancelledAt()
+			if at == 0 {
+				at = pay.GetPaidAt()
+			}
+
+			if inPeriod(at) {
+				cancelled := row(pay, at)
+				cancelled.CancelCount++
+				cancelled.Cancelled += int64(rest)
+				cancelled.Net -= int64(rest)
+			}
+		}
+	}
+
+	summary := &Summary{GroupBy: groupBy}
+	for _, r := range rows {
+		summary.Rows = append(summary.Rows, r)
+	}
+
+	sort.Slice(summary.Rows, func(i, j int) bool {
+		a, b := summary.Rows[i], summary.Rows[j]
+		if a.Date != b.Date {
+			return a.Date < b.Date
+		}
+
+		for _, d := range groupBy {
+			if a.Dimension(d) != b.Dimension(d) {
+				return a.Dimension(d) < b.Dimension(d)
+			}
+		}
+
+		return a.Currency < b.Currency
+	})
+
+	return summary, nil
+}
+
+func validateDimensions(dimensions []Dimension) error {
+	for _, d := range dimensions {
+		if !d.IsValid() {
+			return fmt.Errorf("%s: %s", ErrUnknownDimension, d)
+		}
+	}
+
+	return nil
+}
+
+// CSVOptions CSV 기록 조건
+type CSVOptions struct {
+	Language export.Language // 헤더 언어, 비어있으면 한글
+	NoBOM    bool            // 앞에 UTF-8 BOM 을 붙이지 않는다.
+}
+
+var amountHeaders = map[export.Language][]string{
+	export.Korean:  {"일자", "통화", "결제건수", "결제금액", "취소건수", "취소금액", "순매출"},
+	export.English: {"Date", "Currency", "Paid Count", "Gross", "Cancel Count", "Cancelled", "Net"},
+}
+
+// WriteCSV 집계 결과를 CSV 로 기록한다.
+// 컬럼은 일자, 집계 기준, 통화, 결제건수, 결제금액, 취소건수, 취소금액, 순매출 순이다.
+func (s *Summary) WriteCSV(w io.Writer, opts *CSVOptions) error {
+	if opts == nil {
+		opts = &CSVOptions{}
+	}
+
+	headers, ok := amountHeaders[opts.Language]
+	if !ok {
+		headers = amountHeaders[export.Korean]
+	}
+
+	if !opts.NoBOM {
+		if _, err := w.Write(export.UTF8BOM); err != nil {
+			return err
+		}
+	}
+
+	writer := csv.NewWriter(w)
+
+	header := []string{headers[0]}
+	for _, d := range s.GroupBy {
+		header = append(header, export.LookupColumn(string(d)).Header(opts.Language))
+	}
+	header = append(header, headers[1:]...)
+
+	if err := writer.Write(header); err != nil {
+		return err
+	}
+
+	for _, row := range s.Rows {
+		record := []string{row.Date}
+		for _, d := range s.GroupBy {
+			record = append(record, export.EscapeFormula(row.Dimension(d)))
+		}
+		record = append(record,
+			row.Currency,
+			strconv.Itoa(row.PaidCount),
+			strconv.FormatInt(row.Gross, 10),
+			strconv.Itoa(row.CancelCount),
+			strconv.FormatInt(row.Cancelled, 10),
+			strconv.FormatInt(row.Net, 10),
+		)
+
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
@@ -0,0 +1,190 @@
+package settlement
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	TypePayment "github.com/iamport/interface/gen_src/go/v1/payment"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/iamport/go-iamport/contract"
+	"github.com/iamport/go-iamport/export"
+	"github.com/iamport/go-iamport/iamport"
+	"github.com/iamport/go-iamport/util"
+)
+
+// kst KST 기준 2021-03-day hour:00 의 unix timestamp
+func kst(day, hour int) int32 {
+	return int32(time.Date(2021, 3, day, hour, 0, 0, 0, util.KST).Unix())
+}
+
+var settlementPayments = []*TypePayment.Payment{
+	// 1일 카드 결제, 2일 부분 취소
+	{ImpUid: "imp_1", Status: string(util.StatusPaid), PayMethod: "card", PgProvider: "kcp", CardName: "신한카드", Channel: "pc",
+		Currency: "KRW", Amount: 10000, CancelAmount: 3000, PaidAt: kst(1, 10),
+		CancelHistory: []*TypePayment.CancelHistory{{Amount: 3000, CancelledAt: kst(2, 9)}}},
+	// 1일 카드 결제, UTC 로는 2월 28일
+	{ImpUid: "imp_2", Status: string(util.StatusPaid), PayMethod: "card", PgProvider: "kcp", CardName: "신한카드", Channel: "pc",
+		Currency: "KRW", Amount: 5000, PaidAt: kst(1, 1)},
+	// 1일 계좌이체 결제, 취소 내역 없이 1일 전액 취소
+	{ImpUid: "imp_3", Status: string(util.StatusCancelled), PayMethod: "trans", PgProvider: "inicis", Channel: "mobile",
+		Currency: "KRW", Amount: 7000, PaidAt: kst(1, 12), CancelledAt: kst(1, 13)},
+	// 결제완료 전 실패, 집계하지 않는다.
+	{ImpUid: "imp_4", Status: string(util.StatusFailed), PayMethod: "card", PgProvider: "kcp", Currency: "KRW", Amount: 9000, FailedAt: kst(1, 14)},
+}
+
+func TestAggregate(t *testing.T) {
+	summary, err := Aggregate(settlementPayments, nil, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, AllDimensions, summary.GroupBy)
+	assert.Equal(t, []*Row{
+		{Date: "2021-03-01", PayMethod: "card", PGProvider: "kcp", CardName: "신한카드", Channel: "pc", Currency: "KRW",
+			PaidCount: 2, Gross: 15000, Net: 15000},
+		{Date: "2021-03-01", PayMethod: "trans", PGProvider: "inicis", Channel: "mobile", Currency: "KRW",
+			PaidCount: 1, Gross: 7000, CancelCount: 1, Cancelled: 7000, Net: 0},
+		{Date: "2021-03-02", PayMethod: "card", PGProvider: "kcp", CardName: "신한카드", Channel: "pc", Currency: "KRW",
+			CancelCount: 1, Cancelled: 3000, Net: -3000},
+	}, summary.Rows)
+
+	assert.Equal(t, []*Row{
+		{Currency: "KRW", PaidCount: 3, Gross: 22000, CancelCount: 2, Cancelled: 10000, Net: 12000},
+	}, summary.Totals())
+}
+
+func TestAggregateGroupByAndLocation(t *testing.T) {
+	summary, err := Aggregate(settlementPayments, []Dimension{DimensionPGProvider}, time.UTC)
+	assert.NoError(t, err)
+	assert.Equal(t, []*Row{
+		{Date: "2021-02-28", PGProvider: "kcp", Currency: "KRW", PaidCount: 1, Gross: 5000, Net: 5000},
+		{Date: "2021-03-01", PGProvider: "inicis", Currency: "KRW", PaidCount: 1, Gross: 7000, CancelCount: 1, Cancelled: 7000},
+		{Date: "2021-03-01", PGProvider: "kcp", Currency: "KRW", PaidCount: 1, Gross: 10000, Net: 10000},
+		{Date: "2021-03-02", PGProvider: "kcp", Currency: "KRW", CancelCount: 1, Cancelled: 3000, Net: -3000},
+	}, summary.Rows)
+
+	_, err = Aggregate(settlementPayments, []Dimension{"buyer_name"}, nil)
+	assert.EqualError(t, err, ErrUnknownDimension+": buyer_name")
+}
+
+func TestSummaryWriteCSV(t *testing.T) {
+	summary, err := Aggregate(settlementPayments, []Dimension{DimensionPayMethod}, nil)
+	assert.NoError(t, err)
+
+	var buf bytes.Buffer
+	assert.NoError(t, summary.WriteCSV(&buf, nil))
+	assert.True(t, bytes.HasPrefix(buf.Bytes(), export.UTF8BOM))
+	assert.Equal(t, strings.Join([]string{
+		"일자,결제수단,통화,결제건수,결제금액,취소건수,취소금액,순매출",
+		"2021-03-01,card,KRW,2,15000,0,0,15000",
+		"2021-03-01,trans,KRW,1,7000,1,7000,0",
+		"2021-03-02,card,KRW,0,0,1,3000,-3000",
+		"",
+	}, "\n"), string(buf.Bytes()[len(export.UTF8BOM):]))
+
+	buf.Reset()
+	assert.NoError(t, summary.WriteCSV(&buf, &CSVOptions{Language: export.English, NoBOM: true}))
+	assert.True(t, strings.HasPrefix(buf.String(), "Date,Pay Method,Currency,Paid Count,Gross,Cancel Count,Cancelled,Net\n"))
+}
+
+func TestSummarize(t *testing.T) {
+	server := contract.NewServer(func(w http.ResponseWriter, r *http.Request) {
+		contract.Respond(w, &TypePayment.PaymentPage{List: settlementPayments})
+	})
+	defer server.Close()
+
+	auth, err := server.Authenticate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	iam := iamport.NewIamportWithAuthenticate(auth)
+
+	from := time.Date(2021, 3, 1, 0, 0, 0, 0, util.KST)
+	summary, err := Summarize(context.Background(), iam, &Options{
+		RangeOptions: iamport.RangeOptions{ListOptions: iamport.ListOptions{From: from, To: from.AddDate(0, 0, 7)}},
+		GroupBy:      []Dimension{DimensionChannel},
+	})
+	assert.NoError(t, err)
+	assert.Len(t, summary.Rows, 3)
+	assert.Equal(t, "/payments/status/all", server.LastRequest().Path)
+
+	_, err = Summarize(context.Background(), iam, &Options{GroupBy: []Dimension{"unknown"}})
+	assert.EqualError(t, err, ErrUnknownDimension+": unknown")
+}
+
+func TestSummarizeClipsToPeriod(t *testing.T) {
+	server := contract.NewServer(func(w http.ResponseWriter, r *http.Request) {
+		contract.Respond(w, &TypePayment.PaymentPage{List: settlementPayments})
+	})
+	defer server.Close()
+
+	auth, err := server.Authenticate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	iam := iamport.NewIamportWithAuthenticate(auth)
+
+	// 1일 결제건도 2일 취소 때문에 조회되지만 1일 결제금액은 집계하지 않는다.
+	from := time.Date(2021, 3, 2, 0, 0, 0, 0, util.KST)
+	summary, err := Summarize(context.Background(), iam, &Options{
+		RangeOptions: iamport.RangeOptions{ListOptions: iamport.ListOptions{From: from, To: from.AddDate(0, 0, 1)}},
+		GroupBy:      []Dimension{DimensionPayMethod},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []*Row{
+		{Date: "2021-03-02", PayMethod: "card", Currency: "KRW", CancelCount: 1, Cancelled: 3000, Net: -3000},
+	}, summary.Rows)
+
+	// 2월 1일 ~ 5월 1일은 89일이다. 90일 단위로 나누면 첫 구간이 조회 기간 제한에 걸린다.
+	server.Reset()
+	february := time.Date(2021, 2, 1, 0, 0, 0, 0, util.KST)
+	summary, err = Summarize(context.Background(), iam, &Options{
+		RangeOptions: iamport.RangeOptions{ListOptions: iamport.ListOptions{From: february, To: february.AddDate(1, 0, 0)}},
+		GroupBy:      []Dimension{DimensionPayMethod},
+	})
+	assert.NoError(t, err)
+	assert.Len(t, server.Requests(), 4)
+	assert.Equal(t, []*Row{
+		{Currency: "KRW", PaidCount: 3, Gross: 22000, CancelCount: 2, Cancelled: 10000, Net: 12000},
+	}, summary.Totals())
+}
+
+func TestSummarizeQueriesByUpdated(t *testing.T) {
+	// 2월에 시작, 결제되고 3월 2일에 취소된 결제건. 최종수정시각으로 조회해야만 응답된다.
+	started := time.Date(2021, 2, 20, 10, 0, 0, 0, util.KST)
+	earlier := &TypePayment.Payment{ImpUid: "imp_earlier", Status: string(util.StatusCancelled), PayMethod: "card", Currency: "KRW",
+		Amount: 4000, CancelAmount: 4000, StartedAt: int32(started.Unix()), PaidAt: int32(started.Unix()), CancelledAt: kst(2, 15),
+		CancelHistory: []*TypePayment.CancelHistory{{Amount: 4000, CancelledAt: kst(2, 15)}}}
+
+	server := contract.NewServer(func(w http.ResponseWriter, r *http.Request) {
+		page := &TypePayment.PaymentPage{}
+		if r.URL.Query().Get("sorting") == string(util.SortASCUpdated) {
+			page.List = []*TypePayment.Payment{earlier}
+		}
+		contract.Respond(w, page)
+	})
+	defer server.Close()
+
+	auth, err := server.Authenticate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	iam := iamport.NewIamportWithAuthenticate(auth)
+
+	from := time.Date(2021, 3, 2, 0, 0, 0, 0, util.KST)
+	summary, err := Summarize(context.Background(), iam, &Options{
+		RangeOptions: iamport.RangeOptions{ListOptions: iamport.ListOptions{
+			From:    from,
+			To:      from.AddDate(0, 0, 1),
+			Sorting: util.SortDESCStarted, // 무시된다.
+		}},
+		GroupBy: []Dimension{DimensionPayMethod},
+	})
+	assert.NoError(t, err)
+	assert.Contains(t, server.LastRequest().RawQuery, "sorting=updated")
+	assert.Equal(t, []*Row{
+		{Date: "2021-03-02", PayMethod: "card", Currency: "KRW", CancelCount: 1, Cancelled: 4000, Net: -4000},
+	}, summary.Rows)
+}
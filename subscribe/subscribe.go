@@ -21,9 +21,10 @@ const (
 	URLUnschedule = "/unschedule"
 	URLCustomers  = "/customers"
 
-	URLParamPage = "page="
-	URLParamFrom = "from="
-	URLParamTo   = "to="
+	URLParamPage           = "page="
+	URLParamFrom           = "from="
+	URLParamTo             = "to="
+	URLParamScheduleStatus = "schedule-status="
 )
 
 // Onetime - POST /subscribe/payments/onetime
@@ -32,13 +33,11 @@ const (
 // 동일한 merchant_uid는 재사용이 불가능하며 고유한 값을 전달해주셔야 합니다.
 // 빌링키 저장 시, buyer_email, buyer_name 등의 정보는 customer 부가정보인 customer_email, customer_name 등으로 함께 저장됩니다.
 // /subscribe/customers/{customer_uid} 참조
-func Onetime(client *http.Client, apiDomain string, token string, params *subscribe.OnetimePaymentRequest) (*subscribe.OnetimePaymentResponse, error) {
+// vat_amount, extra 처럼 proto 메시지에 없는 파라미터는 extra로 전달한다.
+func Onetime(client *http.Client, apiDomain string, token string, params *subscribe.OnetimePaymentRequest, extra ...util.Params) (*subscribe.OnetimePaymentResponse, error) {
 	url := util.GetJoinString(apiDomain, URLSubscribe, URLPayments, URLOnetime)
 
-	marshaler := protojson.MarshalOptions{
-		UseProtoNames: true,
-	}
-	jsonBytes, err := marshaler.Marshal(params)
+	jsonBytes, err := util.MarshalParams(params, extra...)
 	if err != nil {
 		return nil, err
 	}
@@ -59,13 +58,10 @@ func Onetime(client *http.Client, apiDomain string, token string, params *subscr
 
 // Again - POST /subscribe/payments/again
 // 저장된 빌링키로 재결제를 하는 경우 사용됩니다. /subscribe/payments/onetime 또는 /subscribe/customers/{customer_uid} 로 등록된 빌링키가 있을 때 매칭되는 customer_uid로 재결제를 진행할 수 있습니다.
-func Again(client *http.Client, apiDomain string, token string, params *subscribe.AgainPaymentRequest) (*subscribe.AgainPaymentResponse, error) {
+func Again(client *http.Client, apiDomain string, token string, params *subscribe.AgainPaymentRequest, extra ...util.Params) (*subscribe.AgainPaymentResponse, error) {
 	url := util.GetJoinString(apiDomain, URLSubscribe, URLPayments, URLAgain)
 
-	marshaler := protojson.MarshalOptions{
-		UseProtoNames: true,
-	}
-	jsonBytes, err := marshaler.Marshal(params)
+	jsonBytes, err := util.MarshalParams(params, extra...)
 	if err != nil {
 		return nil, err
 	}
@@ -157,7 +153,7 @@ func GetScheduledPaymentByMerchantUID(client *http.Client, apiDomain string, tok
 	return &scheduleRes, nil
 }
 
-// GetScheduledPaymentByCustomerUID - GET /subscribe/payments/schedule/customers/{merchant_uid}
+// GetScheduledPaymentByCustomerUID - GET /subscribe/payments/schedule/customers/{customer_uid}
 // 예약한 결제 내역을 가져옵니다
 func GetScheduledPaymentByCustomerUID(client *http.Client, apiDomain string, token string, params *subscribe.GetPaymentScheduleByCustomerRequest) (*subscribe.GetPaymentScheduleByCustomerResponse, error) {
 	urls := []string{apiDomain, URLSubscribe, URLPayments, URLSchedule, URLCustomers, "/", params.GetCustomerUid()}
@@ -166,6 +162,9 @@ func GetScheduledPaymentByCustomerUID(client *http.Client, apiDomain string, tok
 	urls = append(urls, []string{util.GetQueryPrefix(&isFirstQuery), URLParamPage, strconv.Itoa(int(params.GetPage()))}...)
 	urls = append(urls, []string{util.GetQueryPrefix(&isFirstQuery), URLParamFrom, strconv.Itoa(int(params.GetFrom()))}...)
 	urls = append(urls, []string{util.GetQueryPrefix(&isFirstQuery), URLParamTo, strconv.Itoa(int(params.GetTo()))}...)
+	if params.GetScheduleStatus() != "" {
+		urls = append(urls, []string{util.GetQueryPrefix(&isFirstQuery), URLParamScheduleStatus, params.GetScheduleStatus()}...)
+	}
 	urlGetSchedule := strings.Join(urls, "")
 
 	res, err := util.Call(client, token, urlGetSchedule, util.GET)
@@ -1,19 +1,283 @@
 package util
 
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	ErrInvalidPaymentStatus  = "iamport: invalid payment status"
+	ErrInvalidScheduleStatus = "iamport: invalid schedule status"
+	ErrInvalidSort           = "iamport: invalid sort"
+)
+
+// PaymentStatus 결제 상태
+type PaymentStatus string
+
 // Status 결제 상태
-type Status string
+//
+// Deprecated: PaymentStatus 를 사용하세요.
+type Status = PaymentStatus
+
+const (
+	StatusAll       PaymentStatus = "all"       // 전체
+	StatusReady     PaymentStatus = "ready"     // 미결제 (가상계좌 발급 후 입금 대기 포함)
+	StatusPaid      PaymentStatus = "paid"      // 결제완료
+	StatusCancelled PaymentStatus = "cancelled" // 결제취소
+	StatusFailed    PaymentStatus = "failed"    // 결제실패
+
+	// StatusCanceled 결제취소
+	//
+	// Deprecated: 아임포트는 "cancelled" 를 사용한다. StatusCancelled 를 사용하세요.
+	StatusCanceled = StatusCancelled
+)
+
+var paymentStatuses = []PaymentStatus{StatusAll, StatusReady, StatusPaid, StatusCancelled, StatusFailed}
+
+// ParsePaymentStatus 문자열을 결제 상태로 변환한다. 대소문자는 구분하지 않으며 "canceled" 도 허용한다.
+func ParsePaymentStatus(src string) (PaymentStatus, error) {
+	src = strings.ToLower(strings.TrimSpace(src))
+	if src == "canceled" {
+		return StatusCancelled, nil
+	}
+
+	for _, status := range paymentStatuses {
+		if string(status) == src {
+			return status, nil
+		}
+	}
+
+	return "", fmt.Errorf("%s: %q", ErrInvalidPaymentStatus, src)
+}
+
+func (s PaymentStatus) String() string {
+	return string(s)
+}
+
+// IsValid 아임포트에 정의된 결제 상태인지 확인한다. (빈 값은 false)
+func (s PaymentStatus) IsValid() bool {
+	for _, status := range paymentStatuses {
+		if s == status {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsReady 미결제 상태인지 확인한다.
+func (s PaymentStatus) IsReady() bool {
+	return s == StatusReady
+}
+
+// IsPaid 결제완료 상태인지 확인한다.
+func (s PaymentStatus) IsPaid() bool {
+	return s == StatusPaid
+}
+
+// IsCancelled 결제취소 상태인지 확인한다.
+func (s PaymentStatus) IsCancelled() bool {
+	return s == StatusCancelled
+}
+
+// IsFailed 결제실패 상태인지 확인한다.
+func (s PaymentStatus) IsFailed() bool {
+	return s == StatusFailed
+}
+
+// IsFinal 더 이상 상태가 바뀌지 않는 상태(결제취소, 결제실패)인지 확인한다.
+// 결제완료는 취소될 수 있으므로 포함하지 않는다.
+func (s PaymentStatus) IsFinal() bool {
+	return s == StatusCancelled || s == StatusFailed
+}
+
+// MarshalText encoding.TextMarshaler (JSON 직렬화에 사용된다)
+func (s PaymentStatus) MarshalText() ([]byte, error) {
+	if s != "" && !s.IsValid() {
+		return nil, fmt.Errorf("%s: %q", ErrInvalidPaymentStatus, string(s))
+	}
+
+	return []byte(s), nil
+}
+
+// UnmarshalText encoding.TextUnmarshaler (JSON 역직렬화에 사용된다)
+func (s *PaymentStatus) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*s = ""
+		return nil
+	}
+
+	status, err := ParsePaymentStatus(string(text))
+	if err != nil {
+		return err
+	}
+
+	*s = status
+	return nil
+}
+
+// ScheduleStatus 예약결제 상태
+type ScheduleStatus string
+
+const (
+	ScheduleStatusScheduled ScheduleStatus = "scheduled" // 예약됨
+	ScheduleStatusExecuted  ScheduleStatus = "executed"  // 실행됨 (결제 성공/실패 포함)
+	ScheduleStatusRevoked   ScheduleStatus = "revoked"   // 예약 취소됨
+)
+
+var scheduleStatuses = []ScheduleStatus{ScheduleStatusScheduled, ScheduleStatusExecuted, ScheduleStatusRevoked}
+
+// ParseScheduleStatus 문자열을 예약결제 상태로 변환한다. 대소문자는 구분하지 않는다.
+func ParseScheduleStatus(src string) (ScheduleStatus, error) {
+	src = strings.ToLower(strings.TrimSpace(src))
+	for _, status := range scheduleStatuses {
+		if string(status) == src {
+			return status, nil
+		}
+	}
+
+	return "", fmt.Errorf("%s: %q", ErrInvalidScheduleStatus, src)
+}
+
+func (s ScheduleStatus) String() string {
+	return string(s)
+}
+
+// IsValid 아임포트에 정의된 예약결제 상태인지 확인한다. (빈 값은 false)
+func (s ScheduleStatus) IsValid() bool {
+	for _, status := range scheduleStatuses {
+		if s == status {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsPending 아직 실행되지 않은 예약인지 확인한다.
+func (s ScheduleStatus) IsPending() bool {
+	return s == ScheduleStatusScheduled
+}
+
+// MarshalText encoding.TextMarshaler (JSON 직렬화에 사용된다)
+func (s ScheduleStatus) MarshalText() ([]byte, error) {
+	if s != "" && !s.IsValid() {
+		return nil, fmt.Errorf("%s: %q", ErrInvalidScheduleStatus, string(s))
+	}
+
+	return []byte(s), nil
+}
+
+// UnmarshalText encoding.TextUnmarshaler (JSON 역직렬화에 사용된다)
+func (s *ScheduleStatus) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*s = ""
+		return nil
+	}
+
+	status, err := ParseScheduleStatus(string(text))
+	if err != nil {
+		return err
+	}
+
+	*s = status
+	return nil
+}
+
+// Sort 결제 목록 정렬 기준
+type Sort string
+
+const (
+	SortDESCStarted Sort = "-started" // 결제시작시각(결제창오픈시각) 기준 내림차순(DESC) 정렬
+	SortASCStarted  Sort = "started"  // 결제시작시각(결제창오픈시각) 기준 오름차순(ASC) 정렬
+	SortDESCPaid    Sort = "-paid"    // 결제완료시각 기준 내림차순(DESC) 정렬
+	SortASCPaid     Sort = "paid"     // 결제완료시각 기준 오름차순(ASC) 정렬
+	SortDESCUpdated Sort = "-updated" // 최종수정시각(결제건 상태변화마다 수정시각 변경됨) 기준 내림차순(DESC) 정렬
+	SortASCUpdated  Sort = "updated"  // 최종수정시각(결제건 상태변화마다 수정시각 변경됨) 기준 오름차순(ASC) 정렬
+)
+
+var sorts = []Sort{SortDESCStarted, SortASCStarted, SortDESCPaid, SortASCPaid, SortDESCUpdated, SortASCUpdated}
+
+// ParseSort 문자열을 정렬 기준으로 변환한다. 대소문자는 구분하지 않는다.
+func ParseSort(src string) (Sort, error) {
+	src = strings.ToLower(strings.TrimSpace(src))
+	for _, sort := range sorts {
+		if string(sort) == src {
+			return sort, nil
+		}
+	}
+
+	return "", fmt.Errorf("%s: %q", ErrInvalidSort, src)
+}
+
+func (s Sort) String() string {
+	return string(s)
+}
+
+// IsValid 아임포트에 정의된 정렬 기준인지 확인한다. (빈 값은 false)
+func (s Sort) IsValid() bool {
+	for _, sort := range sorts {
+		if s == sort {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsDescending 내림차순 정렬인지 확인한다.
+func (s Sort) IsDescending() bool {
+	return strings.HasPrefix(string(s), "-")
+}
+
+// Field 정렬 기준 시각 (started, paid, updated)
+func (s Sort) Field() string {
+	return strings.TrimPrefix(string(s), "-")
+}
+
+// Reverse 같은 기준의 반대 방향 정렬
+func (s Sort) Reverse() Sort {
+	if s == "" {
+		return ""
+	}
+
+	if s.IsDescending() {
+		return Sort(s.Field())
+	}
+
+	return "-" + s
+}
+
+// MarshalText encoding.TextMarshaler (JSON 직렬화에 사용된다)
+func (s Sort) MarshalText() ([]byte, error) {
+	if s != "" && !s.IsValid() {
+		return nil, fmt.Errorf("%s: %q", ErrInvalidSort, string(s))
+	}
+
+	return []byte(s), nil
+}
+
+// UnmarshalText encoding.TextUnmarshaler (JSON 역직렬화에 사용된다)
+func (s *Sort) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*s = ""
+		return nil
+	}
+
+	sort, err := ParseSort(string(text))
+	if err != nil {
+		return err
+	}
+
+	*s = sort
+	return nil
+}
 
 const (
-	StatusAll      = "all"      // 전체
-	StatusReady    = "ready"    // 미결제
-	StatusPaid     = "paid"     // 결제완료
-	StatusCanceled = "canceled" // 결제취소
-	StatusFailed   = "failed"   // 결제실패
-
-	SortDESCStarted = "-started" // 결제시작시각(결제창오픈시각) 기준 내림차순(DESC) 정렬
-	SortASCStarted  = "started"  // 결제시작시각(결제창오픈시각) 기준 오름차순(ASC) 정렬
-	SortDESCPaid    = "-paid"    // 결제완료시각 기준 내림차순(DESC) 정렬
-	SortASCPaid     = "paid"     // 결제완료시각 기준 오름차순(ASC) 정렬
-	SortDESCUpdated = "-updated" // 최종수정시각(결제건 상태변화마다 수정시각 변경됨) 기준 내림차순(DESC) 정렬
-	SortASCUpdated  = "updated"  // 최종수정시각(결제건 상태변화마다 수정시각 변경됨) 기준 오름차순(ASC) 정렬
+	PayMethodCard  = "card"  // 신용카드
+	PayMethodTrans = "trans" // 실시간계좌이체
+	PayMethodVbank = "vbank" // 가상계좌
+	PayMethodPhone = "phone" // 휴대폰소액결제
+
+	CurrencyKRW = "KRW" // 원화 (아임포트 기본값)
 )
@@ -0,0 +1,90 @@
+package util
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParsePaymentStatus(t *testing.T) {
+	status, err := ParsePaymentStatus("Paid")
+	assert.NoError(t, err)
+	assert.Equal(t, StatusPaid, status)
+
+	// 오타로 쓰이던 canceled 는 아임포트 값인 cancelled 로 변환한다.
+	status, err = ParsePaymentStatus("canceled")
+	assert.NoError(t, err)
+	assert.Equal(t, StatusCancelled, status)
+	assert.Equal(t, "cancelled", StatusCanceled.String())
+
+	_, err = ParsePaymentStatus("error")
+	assert.EqualError(t, err, ErrInvalidPaymentStatus+`: "error"`)
+}
+
+func TestPaymentStatusPredicates(t *testing.T) {
+	assert.True(t, StatusReady.IsReady())
+	assert.True(t, StatusPaid.IsPaid())
+	assert.False(t, StatusPaid.IsFinal())
+	assert.True(t, StatusCancelled.IsCancelled())
+	assert.True(t, StatusCancelled.IsFinal())
+	assert.True(t, StatusFailed.IsFinal())
+	assert.False(t, PaymentStatus("").IsValid())
+	assert.False(t, PaymentStatus("canceled").IsValid())
+}
+
+func TestPaymentStatusJSON(t *testing.T) {
+	type payment struct {
+		Status PaymentStatus `json:"status"`
+	}
+
+	body, err := json.Marshal(payment{Status: StatusCancelled})
+	assert.NoError(t, err)
+	assert.Equal(t, `{"status":"cancelled"}`, string(body))
+
+	var p payment
+	assert.NoError(t, json.Unmarshal([]byte(`{"status":"paid"}`), &p))
+	assert.Equal(t, StatusPaid, p.Status)
+
+	assert.Error(t, json.Unmarshal([]byte(`{"status":"error"}`), &p))
+
+	_, err = json.Marshal(payment{Status: "error"})
+	assert.Error(t, err)
+}
+
+func TestScheduleStatus(t *testing.T) {
+	status, err := ParseScheduleStatus("revoked")
+	assert.NoError(t, err)
+	assert.Equal(t, ScheduleStatusRevoked, status)
+	assert.True(t, ScheduleStatusScheduled.IsPending())
+	assert.False(t, ScheduleStatusExecuted.IsPending())
+
+	_, err = ParseScheduleStatus("paid")
+	assert.Error(t, err)
+
+	var s ScheduleStatus
+	assert.NoError(t, json.Unmarshal([]byte(`"executed"`), &s))
+	assert.Equal(t, ScheduleStatusExecuted, s)
+}
+
+func TestSort(t *testing.T) {
+	sort, err := ParseSort("-updated")
+	assert.NoError(t, err)
+	assert.Equal(t, SortDESCUpdated, sort)
+	assert.True(t, sort.IsDescending())
+	assert.Equal(t, "updated", sort.Field())
+	assert.Equal(t, SortASCUpdated, sort.Reverse())
+	assert.Equal(t, SortDESCPaid, SortASCPaid.Reverse())
+
+	_, err = ParseSort("-error")
+	assert.Error(t, err)
+}
+
+func TestValidateParameters(t *testing.T) {
+	assert.True(t, ValidateStatusParameter(""))
+	assert.True(t, ValidateStatusParameter("cancelled"))
+	assert.False(t, ValidateStatusParameter("canceled"))
+	assert.True(t, ValidateSortParameter(""))
+	assert.True(t, ValidateSortParameter("-paid"))
+	assert.False(t, ValidateSortParameter("error"))
+}
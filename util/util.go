@@ -2,12 +2,19 @@ package util
 
 import (
 	"bytes"
+	"encoding/json"
 	"errors"
+	"fmt"
 	"io/ioutil"
 	"math/rand"
 	"net/http"
+	urllib "net/url"
+	"strconv"
 	"strings"
 	"time"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
 )
 
 const (
@@ -30,6 +37,48 @@ const (
 
 type Method string
 
+// KST 한국 표준시. 아임포트의 조회 기간과 날짜는 KST 기준이다.
+var KST = time.FixedZone("KST", 9*60*60)
+
+// Params proto 메시지에 정의되지 않은 추가 파라미터
+type Params map[string]interface{}
+
+// MarshalParams proto 메시지를 api 파라미터 json으로 변환한다.
+// extra 파라미터가 있으면 proto 필드에 덧붙여서 변환한다.
+func MarshalParams(message proto.Message, extra ...Params) ([]byte, error) {
+	marshaler := protojson.MarshalOptions{
+		UseProtoNames: true,
+	}
+	jsonBytes, err := marshaler.Marshal(message)
+	if err != nil {
+		return nil, err
+	}
+
+	hasExtra := false
+	for _, params := range extra {
+		hasExtra = hasExtra || len(params) > 0
+	}
+	if !hasExtra {
+		return jsonBytes, nil
+	}
+
+	merged := map[string]interface{}{}
+	decoder := json.NewDecoder(bytes.NewReader(jsonBytes))
+	decoder.UseNumber()
+	err = decoder.Decode(&merged)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, params := range extra {
+		for key, value := range params {
+			merged[key] = value
+		}
+	}
+
+	return json.Marshal(merged)
+}
+
 func Call(client *http.Client, token string, url string, method Method) ([]byte, error) {
 	req, err := http.NewRequest(string(method), url, nil)
 
@@ -48,16 +97,13 @@ func Call(client *http.Client, token string, url string, method Method) ([]byte,
 }
 
 func CallWithForm(client *http.Client, token string, url string, method Method, param []byte) ([]byte, error) {
-
 	// json 형식을 form 형태에 맞게 변환
-	jsonStr := string(param)
-	jsonStr = strings.Replace(jsonStr, `{`, "", -1)
-	jsonStr = strings.Replace(jsonStr, `}`, "", -1)
-	jsonStr = strings.Replace(jsonStr, `"`, "", -1)
-	jsonStr = strings.Replace(jsonStr, `:`, "=", -1)
-	jsonStr = strings.Replace(jsonStr, `,`, "&", -1)
-
-	req, err := http.NewRequest(string(method), url, bytes.NewBufferString(jsonStr))
+	form, err := JsonToForm(param)
+	if err != nil {
+		return []byte{}, err
+	}
+
+	req, err := http.NewRequest(string(method), url, bytes.NewBufferString(form.Encode()))
 	if err != nil {
 		return []byte{}, err
 	}
@@ -73,6 +119,48 @@ func CallWithForm(client *http.Client, token string, url string, method Method,
 	return res, nil
 }
 
+// JsonToForm json 객체를 x-www-form-urlencoded 파라미터로 변환한다.
+// 중첩된 객체는 key[sub], 배열은 key[] 형태로 펼친다.
+func JsonToForm(param []byte) (urllib.Values, error) {
+	decoder := json.NewDecoder(bytes.NewReader(param))
+	decoder.UseNumber()
+
+	var src map[string]interface{}
+	err := decoder.Decode(&src)
+	if err != nil {
+		return nil, err
+	}
+
+	form := urllib.Values{}
+	for key, value := range src {
+		flattenForm(form, key, value)
+	}
+
+	return form, nil
+}
+
+func flattenForm(form urllib.Values, key string, value interface{}) {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for sub, subValue := range v {
+			flattenForm(form, key+"["+sub+"]", subValue)
+		}
+	case []interface{}:
+		for _, item := range v {
+			flattenForm(form, key+"[]", item)
+		}
+	case string:
+		form.Add(key, v)
+	case json.Number:
+		form.Add(key, v.String())
+	case bool:
+		form.Add(key, strconv.FormatBool(v))
+	case nil:
+	default:
+		form.Add(key, fmt.Sprint(v))
+	}
+}
+
 func CallWithJson(client *http.Client, token string, url string, method Method, param []byte) ([]byte, error) {
 	req, err := http.NewRequest(string(method), url, bytes.NewReader(param))
 	if err != nil {
@@ -92,6 +180,11 @@ func CallWithJson(client *http.Client, token string, url string, method Method,
 
 func call(client *http.Client, req *http.Request) ([]byte, error) {
 	res, err := client.Do(req)
+	if err != nil {
+		return []byte{}, err
+	}
+	defer res.Body.Close()
+
 	err = errorHandler(res)
 	if err != nil {
 		return []byte{}, err
@@ -114,20 +207,14 @@ func GetQueryPrefix(isFirst *bool) string {
 	}
 }
 
+// ValidateStatusParameter 빈 값(지정하지 않음) 이거나 아임포트에 정의된 결제 상태인지 확인한다.
 func ValidateStatusParameter(src string) bool {
-	if src == "" || src == StatusReady || src == StatusAll || src == StatusPaid || src == StatusFailed || src == StatusCanceled {
-		return true
-	}
-
-	return false
+	return src == "" || PaymentStatus(src).IsValid()
 }
 
+// ValidateSortParameter 빈 값(지정하지 않음) 이거나 아임포트에 정의된 정렬 기준인지 확인한다.
 func ValidateSortParameter(src string) bool {
-	if src == "" || src == SortDESCStarted || src == SortASCStarted || src == SortDESCPaid || src == SortASCPaid || src == SortASCUpdated || src == SortDESCUpdated {
-		return true
-	}
-
-	return false
+	return src == "" || Sort(src).IsValid()
 }
 
 func errorHandler(res *http.Response) error {
@@ -0,0 +1,259 @@
+package validation
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	FieldCardNumber = "card_number"
+	FieldExpiry     = "expiry"
+	FieldBirth      = "birth"
+	FieldPwd2Digit  = "pwd_2digit"
+	FieldAmount     = "amount"
+	FieldTaxFree    = "tax_free"
+	FieldVatAmount  = "vat_amount"
+
+	MsgRequired           = "필수 항목입니다"
+	MsgCardNumberFormat   = "카드번호는 '-'를 제외하고 14~16자리 숫자여야 합니다"
+	MsgCardNumberChecksum = "유효하지 않은 카드번호입니다 (Luhn 검증 실패)"
+	MsgExpiryFormat       = "카드 유효기간은 YYYY-MM 형식이어야 합니다"
+	MsgExpiryPassed       = "카드 유효기간이 지났습니다"
+	MsgBirthFormat        = "생년월일 6자리(YYMMDD) 또는 사업자등록번호 10자리여야 합니다"
+	MsgBirthDate          = "유효하지 않은 생년월일입니다"
+	MsgBusinessNumber     = "유효하지 않은 사업자등록번호입니다"
+	MsgPwd2DigitFormat    = "카드 비밀번호 앞 2자리 숫자여야 합니다"
+	MsgAmountNegative     = "금액은 0 이상이어야 합니다"
+	MsgTaxFreeExceeded    = "면세공급가액은 결제금액보다 클 수 없습니다"
+	MsgVatAmountExceeded  = "부가세는 결제금액에서 면세공급가액을 뺀 금액보다 클 수 없습니다"
+
+	expiryLayout = "2006-01"
+	birthLayout  = "060102"
+)
+
+// FieldError 파라미터 하나에 대한 검증 오류
+type FieldError struct {
+	Field   string
+	Message string
+}
+
+func (e *FieldError) Error() string {
+	return fmt.Sprintf("iamport: %s: %s", e.Field, e.Message)
+}
+
+// Errors 파라미터 검증 오류 목록
+type Errors []*FieldError
+
+func (e Errors) Error() string {
+	messages := make([]string, len(e))
+	for i, fieldErr := range e {
+		messages[i] = fieldErr.Error()
+	}
+
+	return strings.Join(messages, "; ")
+}
+
+// Field 해당 파라미터의 검증 오류를 return 해준다. 오류가 없으면 nil
+func (e Errors) Field(field string) *FieldError {
+	for _, fieldErr := range e {
+		if fieldErr.Field == field {
+			return fieldErr
+		}
+	}
+
+	return nil
+}
+
+// Err 검증 오류가 없으면 nil, 있으면 Errors를 error로 return 해준다.
+func (e Errors) Err() error {
+	if len(e) == 0 {
+		return nil
+	}
+
+	return e
+}
+
+// Add 검증 오류가 있는 경우에만 목록에 추가한다.
+func (e *Errors) Add(fieldErr *FieldError) {
+	if fieldErr != nil {
+		*e = append(*e, fieldErr)
+	}
+}
+
+// Prefix 모든 검증 오류의 필드명 앞에 prefix를 붙인다. (예: schedules[0].)
+func (e Errors) Prefix(prefix string) Errors {
+	prefixed := make(Errors, len(e))
+	for i, fieldErr := range e {
+		prefixed[i] = &FieldError{Field: prefix + fieldErr.Field, Message: fieldErr.Message}
+	}
+
+	return prefixed
+}
+
+// Card 카드 정보를 검증한다.
+// required가 false이고 카드번호가 비어있으면 (이미 등록된 빌링키를 사용하는 경우) 검증하지 않는다.
+func Card(number, expiry, birth, pwd2Digit string, required bool, now time.Time) Errors {
+	errs := Errors{}
+
+	if number == "" && !required {
+		return errs
+	}
+
+	errs.Add(CardNumber(number))
+	errs.Add(Expiry(expiry, now))
+	errs.Add(Birth(birth))
+	errs.Add(Pwd2Digit(pwd2Digit))
+
+	return errs
+}
+
+// CardNumber 카드번호 형식과 Luhn checksum을 검증한다. '-'와 공백은 무시한다.
+func CardNumber(number string) *FieldError {
+	if number == "" {
+		return &FieldError{Field: FieldCardNumber, Message: MsgRequired}
+	}
+
+	digits := strings.NewReplacer("-", "", " ", "").Replace(number)
+	if len(digits) < 14 || len(digits) > 16 || !IsDigits(digits) {
+		return &FieldError{Field: FieldCardNumber, Message: MsgCardNumberFormat}
+	}
+
+	if !luhn(digits) {
+		return &FieldError{Field: FieldCardNumber, Message: MsgCardNumberChecksum}
+	}
+
+	return nil
+}
+
+// Expiry 카드 유효기간(YYYY-MM)을 검증한다. 유효기간이 속한 달의 마지막 날까지 유효하다.
+func Expiry(expiry string, now time.Time) *FieldError {
+	if expiry == "" {
+		return &FieldError{Field: FieldExpiry, Message: MsgRequired}
+	}
+
+	month, err := time.ParseInLocation(expiryLayout, expiry, now.Location())
+	if err != nil {
+		return &FieldError{Field: FieldExpiry, Message: MsgExpiryFormat}
+	}
+
+	if !now.Before(month.AddDate(0, 1, 0)) {
+		return &FieldError{Field: FieldExpiry, Message: MsgExpiryPassed}
+	}
+
+	return nil
+}
+
+// Birth 생년월일 6자리(YYMMDD) 또는 법인카드의 사업자등록번호 10자리를 검증한다.
+func Birth(birth string) *FieldError {
+	if birth == "" {
+		return &FieldError{Field: FieldBirth, Message: MsgRequired}
+	}
+
+	if !IsDigits(birth) {
+		return &FieldError{Field: FieldBirth, Message: MsgBirthFormat}
+	}
+
+	switch len(birth) {
+	case 6:
+		if _, err := time.Parse(birthLayout, birth); err != nil {
+			return &FieldError{Field: FieldBirth, Message: MsgBirthDate}
+		}
+	case 10:
+		if !IsBusinessNumber(birth) {
+			return &FieldError{Field: FieldBirth, Message: MsgBusinessNumber}
+		}
+	default:
+		return &FieldError{Field: FieldBirth, Message: MsgBirthFormat}
+	}
+
+	return nil
+}
+
+// Pwd2Digit 카드 비밀번호 앞 2자리를 검증한다. 법인카드의 경우 생략할 수 있다.
+func Pwd2Digit(pwd2Digit string) *FieldError {
+	if pwd2Digit == "" {
+		return nil
+	}
+
+	if len(pwd2Digit) != 2 || !IsDigits(pwd2Digit) {
+		return &FieldError{Field: FieldPwd2Digit, Message: MsgPwd2DigitFormat}
+	}
+
+	return nil
+}
+
+// Amount 결제금액과 면세공급가액, 부가세의 관계를 검증한다. vatAmount가 nil이면 부가세는 검증하지 않는다.
+func Amount(amount, taxFree int32, vatAmount *int32) Errors {
+	errs := Errors{}
+
+	if amount < 0 {
+		errs.Add(&FieldError{Field: FieldAmount, Message: MsgAmountNegative})
+	}
+
+	if taxFree < 0 {
+		errs.Add(&FieldError{Field: FieldTaxFree, Message: MsgAmountNegative})
+	} else if taxFree > amount {
+		errs.Add(&FieldError{Field: FieldTaxFree, Message: MsgTaxFreeExceeded})
+	}
+
+	if vatAmount != nil {
+		if *vatAmount < 0 {
+			errs.Add(&FieldError{Field: FieldVatAmount, Message: MsgAmountNegative})
+		} else if *vatAmount > amount-taxFree {
+			errs.Add(&FieldError{Field: FieldVatAmount, Message: MsgVatAmountExceeded})
+		}
+	}
+
+	return errs
+}
+
+// IsDigits src 가 비어있지 않고 숫자로만 이루어져 있는지 확인한다.
+func IsDigits(src string) bool {
+	if src == "" {
+		return false
+	}
+
+	for _, r := range src {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+
+	return true
+}
+
+func luhn(digits string) bool {
+	sum := 0
+	double := false
+	for i := len(digits) - 1; i >= 0; i-- {
+		d := int(digits[i] - '0')
+		if double {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+		double = !double
+	}
+
+	return sum%10 == 0
+}
+
+// IsBusinessNumber '-' 없는 사업자등록번호 10자리의 형식과 검증번호(마지막 자리)를 확인한다.
+func IsBusinessNumber(digits string) bool {
+	if len(digits) != 10 || !IsDigits(digits) {
+		return false
+	}
+
+	weights := []int{1, 3, 7, 1, 3, 7, 1, 3, 5}
+
+	sum := 0
+	for i, w := range weights {
+		sum += int(digits[i]-'0') * w
+	}
+	sum += int(digits[8]-'0') * 5 / 10
+
+	return (10-sum%10)%10 == int(digits[9]-'0')
+}
@@ -0,0 +1,93 @@
+package validation
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var now = time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+
+func TestCardNumber(t *testing.T) {
+	assert.Nil(t, CardNumber("1111-2222-3333-4444"))
+	assert.Nil(t, CardNumber("4111 1111 1111 1111"))
+	assert.Nil(t, CardNumber("378282246310005")) // 15자리 (AMEX)
+
+	assert.Equal(t, MsgRequired, CardNumber("").Message)
+	assert.Equal(t, MsgCardNumberFormat, CardNumber("1111-2222").Message)
+	assert.Equal(t, MsgCardNumberFormat, CardNumber("1111-2222-3333-444a").Message)
+	assert.Equal(t, MsgCardNumberChecksum, CardNumber("1111-2222-3333-4445").Message)
+	assert.Equal(t, FieldCardNumber, CardNumber("1111-2222-3333-4445").Field)
+}
+
+func TestExpiry(t *testing.T) {
+	assert.Nil(t, Expiry("2026-10", now))
+	assert.Nil(t, Expiry("2030-01", now))
+
+	assert.Equal(t, MsgRequired, Expiry("", now).Message)
+	assert.Equal(t, MsgExpiryPassed, Expiry("2026-09", now).Message)
+	assert.Equal(t, MsgExpiryFormat, Expiry("202610", now).Message)
+	assert.Equal(t, MsgExpiryFormat, Expiry("10/26", now).Message)
+	assert.Equal(t, MsgExpiryFormat, Expiry("2026-13", now).Message)
+}
+
+func TestBirth(t *testing.T) {
+	assert.Nil(t, Birth("911125"))
+	assert.Nil(t, Birth("2208162517"))
+
+	assert.Equal(t, MsgRequired, Birth("").Message)
+	assert.Equal(t, MsgBirthDate, Birth("911325").Message)
+	assert.Equal(t, MsgBusinessNumber, Birth("1234567890").Message)
+	assert.Equal(t, MsgBirthFormat, Birth("19911125").Message)
+	assert.Equal(t, MsgBirthFormat, Birth("220-81-62517").Message)
+}
+
+func TestIsBusinessNumber(t *testing.T) {
+	assert.True(t, IsBusinessNumber("1248100998"))
+	assert.False(t, IsBusinessNumber("1248100999"))
+	assert.False(t, IsBusinessNumber("124-81-00998"))
+	assert.False(t, IsBusinessNumber("124810099"))
+}
+
+func TestPwd2Digit(t *testing.T) {
+	assert.Nil(t, Pwd2Digit(""))
+	assert.Nil(t, Pwd2Digit("00"))
+
+	assert.Equal(t, MsgPwd2DigitFormat, Pwd2Digit("1").Message)
+	assert.Equal(t, MsgPwd2DigitFormat, Pwd2Digit("123").Message)
+	assert.Equal(t, MsgPwd2DigitFormat, Pwd2Digit("1a").Message)
+}
+
+func TestAmount(t *testing.T) {
+	vat := int32(90)
+	assert.Empty(t, Amount(1000, 100, &vat))
+	assert.Empty(t, Amount(1000, 1000, nil))
+
+	errs := Amount(-1, -1, nil)
+	assert.Equal(t, MsgAmountNegative, errs.Field(FieldAmount).Message)
+	assert.Equal(t, MsgAmountNegative, errs.Field(FieldTaxFree).Message)
+
+	assert.Equal(t, MsgTaxFreeExceeded, Amount(1000, 1001, nil).Field(FieldTaxFree).Message)
+
+	vat = 901
+	assert.Equal(t, MsgVatAmountExceeded, Amount(1000, 100, &vat).Field(FieldVatAmount).Message)
+}
+
+func TestCard(t *testing.T) {
+	assert.Nil(t, Card("", "", "", "", false, now).Err())
+	assert.Nil(t, Card("1111-2222-3333-4444", "2030-01", "911125", "11", true, now).Err())
+
+	errs := Card("", "", "", "", true, now)
+	assert.Len(t, errs, 3)
+
+	errs = Card("1111-2222-3333-4444", "2020-01", "911125", "", false, now)
+	assert.Len(t, errs, 1)
+	assert.EqualError(t, errs.Err(), "iamport: expiry: "+MsgExpiryPassed)
+}
+
+func TestErrorsPrefix(t *testing.T) {
+	errs := Amount(1000, 2000, nil).Prefix("schedules[0].")
+	assert.NotNil(t, errs.Field("schedules[0].tax_free"))
+	assert.Nil(t, errs.Field(FieldTaxFree))
+}
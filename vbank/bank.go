@@ -0,0 +1,83 @@
+package vbank
+
+import (
+	"time"
+
+	"github.com/iamport/go-iamport/util"
+)
+
+// Bank 금융결제원 표준 은행 코드
+// 가상계좌 발급(vbank_code)과 환불 계좌(refund_bank)에 사용한다.
+type Bank string
+
+const (
+	BankKDB       Bank = "002" // KDB산업은행
+	BankIBK       Bank = "003" // IBK기업은행
+	BankKB        Bank = "004" // KB국민은행
+	BankSuhyup    Bank = "007" // 수협은행
+	BankNH        Bank = "011" // NH농협은행
+	BankWoori     Bank = "020" // 우리은행
+	BankSC        Bank = "023" // SC제일은행
+	BankCiti      Bank = "027" // 한국씨티은행
+	BankDaegu     Bank = "031" // 대구은행
+	BankBusan     Bank = "032" // 부산은행
+	BankGwangju   Bank = "034" // 광주은행
+	BankJeju      Bank = "035" // 제주은행
+	BankJeonbuk   Bank = "037" // 전북은행
+	BankKyongnam  Bank = "039" // 경남은행
+	BankKFCC      Bank = "045" // 새마을금고
+	BankCU        Bank = "048" // 신협
+	BankEPost     Bank = "071" // 우체국
+	BankHana      Bank = "081" // 하나은행
+	BankShinhan   Bank = "088" // 신한은행
+	BankKBank     Bank = "089" // 케이뱅크
+	BankKakaoBank Bank = "090" // 카카오뱅크
+	BankTossBank  Bank = "092" // 토스뱅크
+)
+
+var bankNames = map[Bank]string{
+	BankKDB:       "KDB산업은행",
+	BankIBK:       "IBK기업은행",
+	BankKB:        "KB국민은행",
+	BankSuhyup:    "수협은행",
+	BankNH:        "NH농협은행",
+	BankWoori:     "우리은행",
+	BankSC:        "SC제일은행",
+	BankCiti:      "한국씨티은행",
+	BankDaegu:     "대구은행",
+	BankBusan:     "부산은행",
+	BankGwangju:   "광주은행",
+	BankJeju:      "제주은행",
+	BankJeonbuk:   "전북은행",
+	BankKyongnam:  "경남은행",
+	BankKFCC:      "새마을금고",
+	BankCU:        "신협",
+	BankEPost:     "우체국",
+	BankHana:      "하나은행",
+	BankShinhan:   "신한은행",
+	BankKBank:     "케이뱅크",
+	BankKakaoBank: "카카오뱅크",
+	BankTossBank:  "토스뱅크",
+}
+
+// String 은행 코드
+func (b Bank) String() string {
+	return string(b)
+}
+
+// Name 은행 이름. 알 수 없는 코드이면 빈 문자열
+func (b Bank) Name() string {
+	return bankNames[b]
+}
+
+// IsValid 지원하는 은행 코드인지 확인한다.
+func (b Bank) IsValid() bool {
+	_, ok := bankNames[b]
+	return ok
+}
+
+// EndOfDay t 가 속한 날(KST)의 23:59:59. 입금기한을 날짜로 정할 때 사용한다.
+func EndOfDay(t time.Time) time.Time {
+	t = t.In(util.KST)
+	return time.Date(t.Year(), t.Month(), t.Day(), 23, 59, 59, 0, util.KST)
+}
@@ -0,0 +1,148 @@
+package vbank
+
+import (
+	"encoding/json"
+	"net/http"
+	urllib "net/url"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/iamport/go-iamport/util"
+
+	"github.com/iamport/interface/gen_src/go/v1/payment"
+)
+
+const (
+	URLVbanks = "/vbanks"
+	URLHolder = "/holder"
+
+	URLParamBankCode = "bank_code="
+	URLParamBankNum  = "bank_num="
+)
+
+// IssueRequest 가상계좌 발급 요청
+// interface 모듈에 vbanks 메시지가 없어 아임포트 파라미터 이름 그대로 json 으로 보낸다.
+type IssueRequest struct {
+	MerchantUID   string `json:"merchant_uid"`
+	Amount        int32  `json:"amount"`
+	VbankCode     string `json:"vbank_code"`   // 은행 코드
+	VbankDue      int32  `json:"vbank_due"`    // 입금기한 (unix timestamp)
+	VbankHolder   string `json:"vbank_holder"` // 예금주
+	Name          string `json:"name,omitempty"`
+	BuyerName     string `json:"buyer_name,omitempty"`
+	BuyerEmail    string `json:"buyer_email,omitempty"`
+	BuyerTel      string `json:"buyer_tel,omitempty"`
+	BuyerAddr     string `json:"buyer_addr,omitempty"`
+	BuyerPostcode string `json:"buyer_postcode,omitempty"`
+	Pg            string `json:"pg,omitempty"`
+	NoticeURL     string `json:"notice_url,omitempty"`
+	CustomData    string `json:"custom_data,omitempty"`
+}
+
+// EditRequest 가상계좌 정보 수정 요청. 0인 값은 바꾸지 않는다.
+type EditRequest struct {
+	ImpUID   string `json:"-"`
+	Amount   int32  `json:"amount,omitempty"`
+	VbankDue int32  `json:"vbank_due,omitempty"` // 입금기한 (unix timestamp)
+}
+
+// DeleteRequest 가상계좌 발급 취소 요청
+type DeleteRequest struct {
+	ImpUID string `json:"-"`
+}
+
+// Issue - POST /vbanks
+// PG사를 통해 가상계좌를 발급합니다. 발급된 결제건은 ready 상태이며 vbank_num 에 계좌번호가 담겨 있습니다.
+func Issue(client *http.Client, apiDomain string, token string, params *IssueRequest) (*payment.PaymentResponse, error) {
+	urlIssue := strings.Join([]string{apiDomain, URLVbanks}, "")
+
+	return callJson(client, token, urlIssue, util.POST, params)
+}
+
+// Edit - PUT /vbanks/{imp_uid}
+// 입금 전인 가상계좌의 결제금액 또는 입금기한을 변경합니다.
+func Edit(client *http.Client, apiDomain string, token string, params *EditRequest) (*payment.PaymentResponse, error) {
+	urlEdit := strings.Join([]string{apiDomain, URLVbanks, "/", params.ImpUID}, "")
+
+	return callJson(client, token, urlEdit, util.PUT, params)
+}
+
+// Delete - DELETE /vbanks/{imp_uid}
+// 입금 전인 가상계좌의 발급을 취소합니다.
+func Delete(client *http.Client, apiDomain string, token string, params *DeleteRequest) (*payment.PaymentResponse, error) {
+	urlDelete := strings.Join([]string{apiDomain, URLVbanks, "/", params.ImpUID}, "")
+
+	res, err := util.Call(client, token, urlDelete, util.DELETE)
+	if err != nil {
+		return nil, err
+	}
+
+	return unmarshalPayment(res)
+}
+
+func callJson(client *http.Client, token string, url string, method util.Method, params interface{}) (*payment.PaymentResponse, error) {
+	jsonBytes, err := json.Marshal(params)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := util.CallWithJson(client, token, url, method, jsonBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return unmarshalPayment(res)
+}
+
+func unmarshalPayment(res []byte) (*payment.PaymentResponse, error) {
+	paymentRes := payment.PaymentResponse{}
+	err := protojson.Unmarshal(res, &paymentRes)
+	if err != nil {
+		return nil, err
+	}
+
+	return &paymentRes, nil
+}
+
+// HolderRequest 예금주 조회 요청
+type HolderRequest struct {
+	BankCode string // 은행 코드
+	BankNum  string // 계좌번호 ('-' 없이)
+}
+
+// HolderResponse 예금주 조회 응답
+type HolderResponse struct {
+	Code     int32   `json:"code"`
+	Message  string  `json:"message"`
+	Response *Holder `json:"response"`
+}
+
+// Holder 예금주 조회 결과
+type Holder struct {
+	BankHolder string `json:"bank_holder"`
+}
+
+// GetHolder - GET /vbanks/holder
+// 은행 코드와 계좌번호로 예금주를 조회합니다. 환불 계좌 확인에 사용합니다.
+func GetHolder(client *http.Client, apiDomain string, token string, params *HolderRequest) (*HolderResponse, error) {
+	urls := []string{apiDomain, URLVbanks, URLHolder}
+
+	isFirstQuery := true
+	urls = append(urls, []string{util.GetQueryPrefix(&isFirstQuery), URLParamBankCode, urllib.QueryEscape(params.BankCode)}...)
+	urls = append(urls, []string{util.GetQueryPrefix(&isFirstQuery), URLParamBankNum, urllib.QueryEscape(params.BankNum)}...)
+	urlHolder := strings.Join(urls, "")
+
+	res, err := util.Call(client, token, urlHolder, util.GET)
+	if err != nil {
+		return nil, err
+	}
+
+	holderRes := HolderResponse{}
+	err = json.Unmarshal(res, &holderRes)
+	if err != nil {
+		return nil, err
+	}
+
+	return &holderRes, nil
+}